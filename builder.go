@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Builder provides a fluent API for constructing graphs. Instead of adding each
+// vertex and edge with a separate call and error check, the vertices and edges
+// are accumulated using chainable methods and the graph is created by Build:
+//
+//	g, err := graph.NewBuilder(graph.IntHash, graph.Directed(), graph.Weighted()).
+//		Vertex(1).
+//		Vertex(2).
+//		Vertex(3).
+//		Edge(1, 2).Weight(4).
+//		Edge(2, 3).Weight(2).
+//		Build()
+//
+// Errors such as a missing vertex or a duplicate edge are reported by Build.
+type Builder[K comparable, T any] struct {
+	hash     Hash[K, T]
+	traits   []func(*Traits)
+	vertices []builderVertex[T]
+	edges    []builderEdge[K]
+	last     builderElement
+	err      error
+}
+
+type builderVertex[T any] struct {
+	value   T
+	options []func(*VertexProperties)
+}
+
+type builderEdge[K comparable] struct {
+	source, target K
+	options        []func(*EdgeProperties)
+}
+
+type builderElement int
+
+const (
+	builderElementNone builderElement = iota
+	builderElementVertex
+	builderElementEdge
+)
+
+// NewBuilder creates a new [Builder] for a graph with the given hashing function
+// and traits. These are the same arguments that [New] accepts.
+func NewBuilder[K comparable, T any](hash Hash[K, T], options ...func(*Traits)) *Builder[K, T] {
+	return &Builder[K, T]{
+		hash:   hash,
+		traits: options,
+	}
+}
+
+// Vertex adds a vertex with the given value and vertex options to the builder.
+func (b *Builder[K, T]) Vertex(value T, options ...func(*VertexProperties)) *Builder[K, T] {
+	b.vertices = append(b.vertices, builderVertex[T]{value: value, options: options})
+	b.last = builderElementVertex
+	return b
+}
+
+// Edge adds an edge between the given source and target vertex along with the
+// given edge options to the builder. The vertices may be added after the edge,
+// as long as they're added before calling Build.
+func (b *Builder[K, T]) Edge(source, target K, options ...func(*EdgeProperties)) *Builder[K, T] {
+	b.edges = append(b.edges, builderEdge[K]{source: source, target: target, options: options})
+	b.last = builderElementEdge
+	return b
+}
+
+// Weight sets the weight of the vertex or edge that has been added most recently.
+// Calling Weight before adding a vertex or an edge will cause Build to fail.
+func (b *Builder[K, T]) Weight(weight int) *Builder[K, T] {
+	switch b.last {
+	case builderElementVertex:
+		v := &b.vertices[len(b.vertices)-1]
+		v.options = append(v.options, VertexWeight(weight))
+	case builderElementEdge:
+		e := &b.edges[len(b.edges)-1]
+		e.options = append(e.options, EdgeWeight(weight))
+	default:
+		if b.err == nil {
+			b.err = errors.New("weight has to be set after adding a vertex or an edge")
+		}
+	}
+	return b
+}
+
+// Build creates the graph from all vertices and edges added to the builder. All
+// vertices are added before the edges. Build is atomic: If adding any vertex or
+// edge fails, no graph and an error are returned.
+func (b *Builder[K, T]) Build() (Graph[K, T], error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	g := New(b.hash, b.traits...)
+
+	for _, vertex := range b.vertices {
+		if err := g.AddVertex(vertex.value, vertex.options...); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", b.hash(vertex.value), err)
+		}
+	}
+
+	for _, edge := range b.edges {
+		if err := g.AddEdge(edge.source, edge.target, edge.options...); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.source, edge.target, err)
+		}
+	}
+
+	return g, nil
+}
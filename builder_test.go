@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	tests := map[string]struct {
+		builder     *Builder[int, int]
+		expected    func() Graph[int, int]
+		expectedErr error
+		shouldFail  bool
+	}{
+		"fluent build matches imperative calls": {
+			builder: NewBuilder(IntHash, Directed(), Weighted()).
+				Vertex(1).
+				Vertex(2).Weight(5).
+				Vertex(3, VertexAttribute("color", "red")).
+				Edge(1, 2).Weight(4).
+				Edge(2, 3, EdgeAttribute("label", "2-3")).Weight(2),
+			expected: func() Graph[int, int] {
+				g := New(IntHash, Directed(), Weighted())
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2, VertexWeight(5))
+				_ = g.AddVertex(3, VertexAttribute("color", "red"))
+				_ = g.AddEdge(1, 2, EdgeWeight(4))
+				_ = g.AddEdge(2, 3, EdgeAttribute("label", "2-3"), EdgeWeight(2))
+				return g
+			},
+		},
+		"edge declared before its vertices": {
+			builder: NewBuilder(IntHash).
+				Edge(1, 2).
+				Vertex(1).
+				Vertex(2),
+			expected: func() Graph[int, int] {
+				g := New(IntHash)
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddEdge(1, 2)
+				return g
+			},
+		},
+		"missing vertex": {
+			builder: NewBuilder(IntHash).
+				Vertex(1).
+				Edge(1, 2),
+			expectedErr: ErrVertexNotFound,
+			shouldFail:  true,
+		},
+		"duplicate edge": {
+			builder: NewBuilder(IntHash, Directed()).
+				Vertex(1).
+				Vertex(2).
+				Edge(1, 2).
+				Edge(1, 2),
+			expectedErr: ErrEdgeAlreadyExists,
+			shouldFail:  true,
+		},
+		"weight without element": {
+			builder:    NewBuilder(IntHash).Weight(3).Vertex(1),
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := test.builder.Build()
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+				t.Errorf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.shouldFail {
+				if g != nil {
+					t.Errorf("expected no graph, got %v", g)
+				}
+				return
+			}
+
+			expected := test.expected()
+
+			if !traitsAreEqual(expected.Traits(), g.Traits()) {
+				t.Errorf("expected traits %v, got %v", expected.Traits(), g.Traits())
+			}
+
+			expectedAdjacencyMap, _ := expected.AdjacencyMap()
+			adjacencyMap, _ := g.AdjacencyMap()
+
+			if !adjacencyMapsAreEqual(expectedAdjacencyMap, adjacencyMap, func(a, b Edge[int]) bool {
+				return a.Source == b.Source && a.Target == b.Target
+			}) {
+				t.Errorf("expected adjacency map %v, got %v", expectedAdjacencyMap, adjacencyMap)
+			}
+
+			for vertex := range expectedAdjacencyMap {
+				_, expectedProperties, _ := expected.VertexWithProperties(vertex)
+				_, properties, _ := g.VertexWithProperties(vertex)
+
+				if !vertexPropertiesAreEqual(expectedProperties, properties) {
+					t.Errorf("expected properties %v for vertex %v, got %v", expectedProperties, vertex, properties)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"math"
 	"reflect"
 	"testing"
 )
@@ -137,6 +138,20 @@ func TestPriorityQueue_UpdatePriority(t *testing.T) {
 				{value: 10, priority: 10},
 			},
 		},
+		"decrease an item with infinite priority": {
+			items: []*priorityItem[int]{
+				{value: 10, priority: 10},
+				{value: 20, priority: math.Inf(1)},
+				{value: 30, priority: math.Inf(1)},
+			},
+			decreaseItem:     30,
+			decreasePriority: 5,
+			expectedPriorityItems: []*priorityItem[int]{
+				{value: 20, priority: math.Inf(1)},
+				{value: 10, priority: 10},
+				{value: 30, priority: 5},
+			},
+		},
 		"increase 10 to priority 100": {
 			items: []*priorityItem[int]{
 				{value: 40, priority: 40},
@@ -0,0 +1,250 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ColoringConflicts checks the given vertex coloring, which maps each vertex hash
+// to its color, and returns all edges whose source and target vertex have the
+// same color. Each edge of an undirected graph is reported only once. If a
+// vertex is not contained in the coloring, an error will be returned.
+func ColoringConflicts[K comparable, T any](g Graph[K, T], coloring map[K]int) ([]Edge[K], error) {
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for vertex := range adjacencyMap {
+		if _, ok := coloring[vertex]; !ok {
+			return nil, fmt.Errorf("vertex %v has no color", vertex)
+		}
+	}
+
+	conflicts := make([]Edge[K], 0)
+
+	for _, edge := range edges {
+		if coloring[edge.Source] == coloring[edge.Target] {
+			conflicts = append(conflicts, edge)
+		}
+	}
+
+	return conflicts, nil
+}
+
+// IndependentSetApprox computes a large independent set of the given graph, i.e.
+// a set of vertices where no two vertices are adjacent, by greedily picking the
+// vertex with the fewest neighbors. The set is not guaranteed to be maximum, but
+// no vertex can be added to it. Edge directions are ignored, and vertices with a
+// self-loop are never part of an independent set.
+func IndependentSetApprox[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	vertices, neighbors, err := independenceNeighbors(g)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := make(map[K]struct{}, len(vertices))
+	for _, vertex := range vertices {
+		if _, ok := neighbors[vertex][vertex]; !ok {
+			remaining[vertex] = struct{}{}
+		}
+	}
+
+	degrees := make(map[K]int, len(remaining))
+	for vertex := range remaining {
+		for neighbor := range neighbors[vertex] {
+			if _, ok := remaining[neighbor]; ok {
+				degrees[vertex]++
+			}
+		}
+	}
+
+	set := make([]K, 0)
+
+	for len(remaining) > 0 {
+		var chosen K
+		found := false
+
+		// Iterating over the sorted vertices makes the choice deterministic
+		// if multiple vertices have the fewest neighbors.
+		for _, vertex := range vertices {
+			if _, ok := remaining[vertex]; !ok {
+				continue
+			}
+			if !found || degrees[vertex] < degrees[chosen] {
+				chosen, found = vertex, true
+			}
+		}
+
+		set = append(set, chosen)
+
+		removed := []K{chosen}
+		for neighbor := range neighbors[chosen] {
+			if _, ok := remaining[neighbor]; ok {
+				removed = append(removed, neighbor)
+			}
+		}
+
+		for _, vertex := range removed {
+			delete(remaining, vertex)
+		}
+
+		for _, vertex := range removed {
+			for neighbor := range neighbors[vertex] {
+				if _, ok := remaining[neighbor]; ok {
+					degrees[neighbor]--
+				}
+			}
+		}
+	}
+
+	return set, nil
+}
+
+// MaximumIndependentSet computes an independent set of maximum size for the
+// given graph, ignoring edge directions. It uses branch and bound, which takes
+// exponential time in the worst case, so graphs with more than maxVertices
+// vertices are rejected with an error. For those, use [IndependentSetApprox].
+func MaximumIndependentSet[K comparable, T any](g Graph[K, T], maxVertices int) ([]K, error) {
+	vertices, neighbors, err := independenceNeighbors(g)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vertices) > maxVertices {
+		return nil, fmt.Errorf("graph has %d vertices, which exceeds the limit of %d", len(vertices), maxVertices)
+	}
+
+	// The greedy solution serves as the initial lower bound.
+	best, err := IndependentSetApprox(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute initial independent set: %w", err)
+	}
+
+	indices := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		indices[vertex] = i
+	}
+
+	adjacent := make([][]bool, len(vertices))
+	candidates := make([]int, 0, len(vertices))
+
+	for i, vertex := range vertices {
+		adjacent[i] = make([]bool, len(vertices))
+		for neighbor := range neighbors[vertex] {
+			adjacent[i][indices[neighbor]] = true
+		}
+		if !adjacent[i][i] {
+			candidates = append(candidates, i)
+		}
+	}
+
+	search := &independentSetSearch{
+		adjacent: adjacent,
+		best:     len(best),
+	}
+
+	search.branch(make([]int, 0), candidates)
+
+	if search.bestSet == nil {
+		return best, nil
+	}
+
+	set := make([]K, len(search.bestSet))
+	for i, index := range search.bestSet {
+		set[i] = vertices[index]
+	}
+
+	return set, nil
+}
+
+// independentSetSearch holds the state of the branch-and-bound search for a
+// maximum independent set, where each vertex has been replaced with its index.
+type independentSetSearch struct {
+	adjacent [][]bool
+	best     int
+	bestSet  []int
+}
+
+// branch extends the given independent set with vertices from the candidates,
+// none of which is adjacent to a vertex of the set. If a set larger than the
+// best one found so far is found, it becomes the new best set.
+func (s *independentSetSearch) branch(set, candidates []int) {
+	if len(set)+len(candidates) <= s.best {
+		return
+	}
+
+	// Picking the candidate with the most neighbors among the candidates
+	// shrinks the remaining candidates as quickly as possible.
+	pivot, pivotDegree := -1, -1
+
+	for _, candidate := range candidates {
+		degree := 0
+		for _, other := range candidates {
+			if s.adjacent[candidate][other] {
+				degree++
+			}
+		}
+		if degree > pivotDegree {
+			pivot, pivotDegree = candidate, degree
+		}
+	}
+
+	// If none of the candidates are adjacent, all of them can be added.
+	if pivotDegree <= 0 {
+		s.best = len(set) + len(candidates)
+		s.bestSet = append(append(make([]int, 0, s.best), set...), candidates...)
+		return
+	}
+
+	withPivot := make([]int, 0, len(candidates))
+	withoutPivot := make([]int, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		if candidate == pivot {
+			continue
+		}
+		withoutPivot = append(withoutPivot, candidate)
+		if !s.adjacent[pivot][candidate] {
+			withPivot = append(withPivot, candidate)
+		}
+	}
+
+	s.branch(append(set[:len(set):len(set)], pivot), withPivot)
+	s.branch(set, withoutPivot)
+}
+
+// independenceNeighbors returns the vertices of the given graph sorted by their
+// hashes along with the neighbors of each vertex, ignoring edge directions.
+func independenceNeighbors[K comparable, T any](g Graph[K, T]) ([]K, map[K]map[K]struct{}, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	neighbors := make(map[K]map[K]struct{}, len(adjacencyMap))
+
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+		neighbors[vertex] = make(map[K]struct{})
+	}
+
+	for vertex, adjacencies := range adjacencyMap {
+		for adjacency := range adjacencies {
+			neighbors[vertex][adjacency] = struct{}{}
+			neighbors[adjacency][vertex] = struct{}{}
+		}
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return keyLess(vertices[i], vertices[j])
+	})
+
+	return vertices, neighbors, nil
+}
@@ -0,0 +1,315 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestColoringConflicts(t *testing.T) {
+	tests := map[string]struct {
+		traits            []func(*Traits)
+		vertices          []int
+		edges             []Edge[int]
+		coloring          map[int]int
+		expectedConflicts []Edge[int]
+		shouldFail        bool
+	}{
+		"valid coloring of a triangle": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			coloring:          map[int]int{1: 0, 2: 1, 3: 2},
+			expectedConflicts: []Edge[int]{},
+		},
+		"invalid coloring of a triangle": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			coloring: map[int]int{1: 0, 2: 1, 3: 0},
+			expectedConflicts: []Edge[int]{
+				{Source: 3, Target: 1},
+			},
+		},
+		"invalid coloring of a directed path": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			coloring: map[int]int{1: 0, 2: 0, 3: 0},
+			expectedConflicts: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+		},
+		"vertex without color": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			coloring:   map[int]int{1: 0},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			conflicts, err := ColoringConflicts(g, test.coloring)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if len(conflicts) != len(test.expectedConflicts) {
+				t.Fatalf("expected %d conflicts, got %d: %v", len(test.expectedConflicts), len(conflicts), conflicts)
+			}
+
+			for _, expected := range test.expectedConflicts {
+				found := false
+				for _, conflict := range conflicts {
+					if edgesAreEqual(expected, conflict, g.Traits().IsDirected) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected conflict %v, got %v", expected, conflicts)
+				}
+			}
+		})
+	}
+}
+
+func TestIndependentSetApprox(t *testing.T) {
+	tests := map[string]struct {
+		traits       []func(*Traits)
+		generate     func(g Graph[int, int]) error
+		expectedSize int
+	}{
+		"star graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return StarGraph(g, 6)
+			},
+			expectedSize: 5,
+		},
+		"path graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return PathGraph(g, 7)
+			},
+			expectedSize: 4,
+		},
+		"complete graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return CompleteGraph(g, 5)
+			},
+			expectedSize: 1,
+		},
+		"directed cycle graph": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				return CycleGraph(g, 6)
+			},
+			expectedSize: 3,
+		},
+		"graph with self-loop": {
+			traits: []func(*Traits){AllowSelfLoops()},
+			generate: func(g Graph[int, int]) error {
+				if err := PathGraph(g, 3); err != nil {
+					return err
+				}
+				return g.AddEdge(0, 0)
+			},
+			expectedSize: 1,
+		},
+		"empty graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return nil
+			},
+			expectedSize: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			if err := test.generate(g); err != nil {
+				t.Fatalf("failed to generate graph: %s", err.Error())
+			}
+
+			set, err := IndependentSetApprox(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(set) != test.expectedSize {
+				t.Errorf("expected %d vertices, got %d: %v", test.expectedSize, len(set), set)
+			}
+
+			if !isIndependentSet(g, set) {
+				t.Errorf("expected %v to be an independent set", set)
+			}
+		})
+	}
+}
+
+func TestMaximumIndependentSet(t *testing.T) {
+	tests := map[string]struct {
+		traits       []func(*Traits)
+		generate     func(g Graph[int, int]) error
+		maxVertices  int
+		expectedSize int
+		shouldFail   bool
+	}{
+		"odd cycle graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return CycleGraph(g, 7)
+			},
+			maxVertices:  10,
+			expectedSize: 3,
+		},
+		"petersen graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				if err := addGeneratedVertices(g, 10); err != nil {
+					return err
+				}
+				for i := 0; i < 5; i++ {
+					edges := [][2]int{
+						{i, (i + 1) % 5},
+						{i, i + 5},
+						{i + 5, (i+2)%5 + 5},
+					}
+					for _, edge := range edges {
+						if err := g.AddEdge(edge[0], edge[1]); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			},
+			maxVertices:  10,
+			expectedSize: 4,
+		},
+		"graph where the greedy heuristic isn't optimal": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				// Vertex 0 has the fewest neighbors, but choosing it rules out
+				// the independent set {1, 2, 3}.
+				if err := addGeneratedVertices(g, 7); err != nil {
+					return err
+				}
+				edges := [][2]int{
+					{0, 1}, {0, 2}, {0, 3},
+					{1, 4}, {1, 5}, {1, 6},
+					{2, 4}, {2, 5}, {2, 6},
+					{3, 4}, {3, 5}, {3, 6},
+					{4, 5}, {4, 6}, {5, 6},
+				}
+				for _, edge := range edges {
+					if err := g.AddEdge(edge[0], edge[1]); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			maxVertices:  7,
+			expectedSize: 3,
+		},
+		"grid graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return GridGraph(g, 4, 4)
+			},
+			maxVertices:  16,
+			expectedSize: 8,
+		},
+		"empty graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return nil
+			},
+			maxVertices:  0,
+			expectedSize: 0,
+		},
+		"graph exceeding the limit": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return PathGraph(g, 5)
+			},
+			maxVertices: 4,
+			shouldFail:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			if err := test.generate(g); err != nil {
+				t.Fatalf("failed to generate graph: %s", err.Error())
+			}
+
+			set, err := MaximumIndependentSet(g, test.maxVertices)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if len(set) != test.expectedSize {
+				t.Errorf("expected %d vertices, got %d: %v", test.expectedSize, len(set), set)
+			}
+
+			if !isIndependentSet(g, set) {
+				t.Errorf("expected %v to be an independent set", set)
+			}
+		})
+	}
+}
+
+// isIndependentSet determines whether no two vertices of the given set are
+// adjacent, regardless of the edge direction.
+func isIndependentSet(g Graph[int, int], set []int) bool {
+	for _, a := range set {
+		for _, b := range set {
+			if _, err := g.Edge(a, b); err == nil {
+				return false
+			}
+		}
+	}
+
+	return true
+}
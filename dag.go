@@ -146,6 +146,261 @@ func StableTopologicalSort[K comparable, T any](g Graph[K, T], less func(K, K) b
 	return order, nil
 }
 
+// IsTopologicalOrder determines whether the given order of vertex hashes is a
+// valid topological order of the given directed graph. This is the case if the
+// order contains each vertex of the graph exactly once and if for each edge
+// (A,B), vertex A appears before vertex B.
+func IsTopologicalOrder[K comparable, T any](g Graph[K, T], order []K) (bool, error) {
+	if !g.Traits().IsDirected {
+		return false, fmt.Errorf("topological order cannot be checked on undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	if len(order) != len(adjacencyMap) {
+		return false, nil
+	}
+
+	positions := make(map[K]int, len(order))
+
+	for i, vertex := range order {
+		if _, ok := adjacencyMap[vertex]; !ok {
+			return false, nil
+		}
+		if _, ok := positions[vertex]; ok {
+			return false, nil
+		}
+		positions[vertex] = i
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			if positions[source] >= positions[target] {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// TopologicalGenerations groups the vertices of the given directed acyclic graph
+// into generations. Generation 0 contains all vertices without predecessors, and
+// each vertex is placed in the earliest generation after all of its predecessors.
+// Concatenating all generations yields a topological order. The order within a
+// generation is not guaranteed to be stable, and graphs with cycles are rejected.
+func TopologicalGenerations[K comparable, T any](g Graph[K, T]) ([][]K, error) {
+	if !g.Traits().IsDirected {
+		return nil, fmt.Errorf("topological generations cannot be computed on undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	inDegrees := make(map[K]int, len(adjacencyMap))
+	for _, adjacencies := range adjacencyMap {
+		for adjacency := range adjacencies {
+			inDegrees[adjacency]++
+		}
+	}
+
+	generation := make([]K, 0)
+	for vertex := range adjacencyMap {
+		if inDegrees[vertex] == 0 {
+			generation = append(generation, vertex)
+		}
+	}
+
+	generations := make([][]K, 0)
+	count := 0
+
+	for len(generation) > 0 {
+		generations = append(generations, generation)
+		count += len(generation)
+
+		next := make([]K, 0)
+
+		for _, vertex := range generation {
+			for adjacency := range adjacencyMap[vertex] {
+				inDegrees[adjacency]--
+				if inDegrees[adjacency] == 0 {
+					next = append(next, adjacency)
+				}
+			}
+		}
+
+		generation = next
+	}
+
+	if count != len(adjacencyMap) {
+		return nil, errors.New("topological generations cannot be computed on graph with cycles")
+	}
+
+	return generations, nil
+}
+
+// IsDAG determines whether the given graph is a directed acyclic graph using
+// Kahn's algorithm. An undirected graph is never a DAG, and a self-loop is a
+// cycle.
+func IsDAG[K comparable, T any](g Graph[K, T]) (bool, error) {
+	if !g.Traits().IsDirected {
+		return false, nil
+	}
+
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	inDegrees := make(map[K]int, len(adjacencyMap))
+	for _, adjacencies := range adjacencyMap {
+		for adjacency := range adjacencies {
+			inDegrees[adjacency]++
+		}
+	}
+
+	queue := make([]K, 0)
+	for vertex := range adjacencyMap {
+		if inDegrees[vertex] == 0 {
+			queue = append(queue, vertex)
+		}
+	}
+
+	removed := 0
+
+	for len(queue) > 0 {
+		vertex := queue[0]
+		queue = queue[1:]
+		removed++
+
+		for adjacency := range adjacencyMap[vertex] {
+			inDegrees[adjacency]--
+			if inDegrees[adjacency] == 0 {
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	return removed == len(adjacencyMap), nil
+}
+
+// FeedbackArcSet computes a set of edges of the given directed graph whose
+// removal makes the graph acyclic. Since finding a minimum feedback arc set is
+// NP-hard, it uses the greedy heuristic by Eades, Lin and Smyth, which runs in
+// O(|V|^2+|E|) time. The set is not guaranteed to be minimal, always contains
+// all self-loops, and is empty for a DAG.
+func FeedbackArcSet[K comparable, T any](g Graph[K, T]) ([]Edge[K], error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("feedback arc set cannot be computed on undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	// Self-loops don't affect the order and always end up in the feedback arc
+	// set, so they aren't counted.
+	outDegrees := make(map[K]int, len(adjacencyMap))
+	inDegrees := make(map[K]int, len(adjacencyMap))
+	remaining := make(map[K]struct{}, len(adjacencyMap))
+
+	for vertex, adjacencies := range adjacencyMap {
+		remaining[vertex] = struct{}{}
+		for adjacency := range adjacencies {
+			if adjacency != vertex {
+				outDegrees[vertex]++
+				inDegrees[adjacency]++
+			}
+		}
+	}
+
+	remove := func(vertex K) {
+		delete(remaining, vertex)
+		for adjacency := range adjacencyMap[vertex] {
+			inDegrees[adjacency]--
+		}
+		for predecessor := range predecessorMap[vertex] {
+			outDegrees[predecessor]--
+		}
+	}
+
+	find := func(matches func(K) bool) (K, bool) {
+		for vertex := range remaining {
+			if matches(vertex) {
+				return vertex, true
+			}
+		}
+		var zero K
+		return zero, false
+	}
+
+	head := make([]K, 0, len(adjacencyMap))
+	tail := make([]K, 0)
+
+	for len(remaining) > 0 {
+		if sink, ok := find(func(vertex K) bool { return outDegrees[vertex] == 0 }); ok {
+			tail = append(tail, sink)
+			remove(sink)
+			continue
+		}
+
+		if source, ok := find(func(vertex K) bool { return inDegrees[vertex] == 0 }); ok {
+			head = append(head, source)
+			remove(source)
+			continue
+		}
+
+		var best K
+		bestDelta, found := 0, false
+
+		for vertex := range remaining {
+			delta := outDegrees[vertex] - inDegrees[vertex]
+			if !found || delta > bestDelta {
+				best, bestDelta, found = vertex, delta, true
+			}
+		}
+
+		head = append(head, best)
+		remove(best)
+	}
+
+	// The sinks have been collected from the end of the order, so they have to
+	// be appended in reverse.
+	positions := make(map[K]int, len(adjacencyMap))
+	for i, vertex := range head {
+		positions[vertex] = i
+	}
+	for i := len(tail) - 1; i >= 0; i-- {
+		positions[tail[i]] = len(head) + len(tail) - 1 - i
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	feedbackArcs := make([]Edge[K], 0)
+
+	for _, edge := range edges {
+		if positions[edge.Source] >= positions[edge.Target] {
+			feedbackArcs = append(feedbackArcs, edge)
+		}
+	}
+
+	return feedbackArcs, nil
+}
+
 // TransitiveReduction returns a new graph with the same vertices and the same
 // reachability as the given graph, but with as few edges as possible. The graph
 // must be a directed acyclic graph.
@@ -156,16 +411,27 @@ func TransitiveReduction[K comparable, T any](g Graph[K, T]) (Graph[K, T], error
 		return nil, fmt.Errorf("transitive reduction cannot be performed on undirected graph")
 	}
 
+	// The transitive reduction of a graph with cycles is not unique, so such
+	// graphs are rejected before doing any work.
+	if _, err := TopologicalSort(g); err != nil {
+		return nil, fmt.Errorf("transitive reduction cannot be performed on graph with cycle")
+	}
+
 	transitiveReduction, err := g.Clone()
 	if err != nil {
 		return nil, fmt.Errorf("failed to clone the graph: %w", err)
 	}
 
-	adjacencyMap, err := transitiveReduction.AdjacencyMap()
+	adjacencyMap, err := readAdjacencyMap(g)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get adajcency map: %w", err)
 	}
 
+	// The redundant edges are only collected while traversing the adjacency
+	// map and removed afterwards, so that the graph isn't modified while its
+	// adjacency map is being iterated.
+	redundantEdges := make(map[EdgeKey[K]]struct{})
+
 	// For each vertex in the graph, run a depth-first search from each direct
 	// successor of that vertex. Then, for each vertex visited within the DFS,
 	// inspect all of its edges. Remove the edges that also appear in the edge
@@ -173,14 +439,9 @@ func TransitiveReduction[K comparable, T any](g Graph[K, T]) (Graph[K, T], error
 	// are redundant because their targets apparently are not only reachable
 	// from the top-level vertex, but also through a DFS.
 	for vertex, successors := range adjacencyMap {
-		tOrder, err := transitiveReduction.Order()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get graph order: %w", err)
-		}
-
 		for successor := range successors {
 			stack := newStack[K]()
-			visited := make(map[K]struct{}, tOrder)
+			visited := make(map[K]struct{}, len(adjacencyMap))
 
 			stack.push(successor)
 
@@ -192,20 +453,10 @@ func TransitiveReduction[K comparable, T any](g Graph[K, T]) (Graph[K, T], error
 				}
 
 				visited[current] = struct{}{}
-				stack.push(current)
 
 				for adjacency := range adjacencyMap[current] {
-					if _, ok := visited[adjacency]; ok {
-						if stack.contains(adjacency) {
-							// If the current adjacency is both on the stack and
-							// has already been visited, there is a cycle.
-							return nil, fmt.Errorf("transitive reduction cannot be performed on graph with cycle")
-						}
-						continue
-					}
-
 					if _, ok := adjacencyMap[vertex][adjacency]; ok {
-						_ = transitiveReduction.RemoveEdge(vertex, adjacency)
+						redundantEdges[EdgeKey[K]{Source: vertex, Target: adjacency}] = struct{}{}
 					}
 					stack.push(adjacency)
 				}
@@ -213,5 +464,59 @@ func TransitiveReduction[K comparable, T any](g Graph[K, T]) (Graph[K, T], error
 		}
 	}
 
+	for edge := range redundantEdges {
+		if err := transitiveReduction.RemoveEdge(edge.Source, edge.Target); err != nil {
+			return nil, fmt.Errorf("failed to remove edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
 	return transitiveReduction, nil
 }
+
+// WeightedTransitiveClosure computes the weight of the shortest path from A to C
+// for each pair of vertices where C is reachable from A, i.e. closure[A][C]. A
+// vertex is only reachable from itself if it is part of a cycle. For unweighted
+// graphs, each edge has a weight of 1.
+//
+// WeightedTransitiveClosure uses the Floyd-Warshall algorithm in O(|V|^3) time.
+// If the graph contains a negative-weight cycle, an error wrapping
+// ErrNegativeCycle will be returned.
+func WeightedTransitiveClosure[K comparable, T any](g Graph[K, T]) (map[K]map[K]float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	weight := dijkstraEdgeWeight(g)
+	closure := make(map[K]map[K]float64, len(adjacencyMap))
+
+	for source, adjacencies := range adjacencyMap {
+		closure[source] = make(map[K]float64, len(adjacencies))
+		for target, edge := range adjacencies {
+			closure[source][target] = weight(edge)
+		}
+	}
+
+	for via := range adjacencyMap {
+		for source := range adjacencyMap {
+			toVia, ok := closure[source][via]
+			if !ok {
+				continue
+			}
+			for target, fromVia := range closure[via] {
+				current, ok := closure[source][target]
+				if !ok || toVia+fromVia < current {
+					closure[source][target] = toVia + fromVia
+				}
+			}
+		}
+	}
+
+	for vertex := range adjacencyMap {
+		if cycleWeight, ok := closure[vertex][vertex]; ok && cycleWeight < 0 {
+			return nil, fmt.Errorf("vertex %v is part of a negative cycle: %w", vertex, ErrNegativeCycle)
+		}
+	}
+
+	return closure, nil
+}
@@ -1,7 +1,9 @@
 package graph
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -180,6 +182,403 @@ func TestDirectedStableTopologicalSort(t *testing.T) {
 	}
 }
 
+func TestDirectedIsTopologicalOrder(t *testing.T) {
+	vertices := []int{1, 2, 3, 4, 5}
+	edges := []Edge[int]{
+		{Source: 1, Target: 2},
+		{Source: 1, Target: 3},
+		{Source: 2, Target: 4},
+		{Source: 3, Target: 4},
+		{Source: 4, Target: 5},
+	}
+
+	tests := map[string]struct {
+		order    []int
+		expected bool
+	}{
+		"valid order": {
+			order:    []int{1, 2, 3, 4, 5},
+			expected: true,
+		},
+		"another valid order": {
+			order:    []int{1, 3, 2, 4, 5},
+			expected: true,
+		},
+		"swapped dependent vertices": {
+			order:    []int{1, 2, 4, 3, 5},
+			expected: false,
+		},
+		"missing vertex": {
+			order:    []int{1, 2, 3, 4},
+			expected: false,
+		},
+		"duplicate vertex": {
+			order:    []int{1, 2, 3, 4, 4},
+			expected: false,
+		},
+		"unknown vertex": {
+			order:    []int{1, 2, 3, 4, 6},
+			expected: false,
+		},
+	}
+
+	g := New(IntHash, Directed())
+
+	for _, vertex := range vertices {
+		_ = g.AddVertex(vertex)
+	}
+
+	for _, edge := range edges {
+		if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+			t.Fatalf("failed to add edge: %s", err.Error())
+		}
+	}
+
+	order, _ := TopologicalSort(g)
+
+	if ok, err := IsTopologicalOrder(g, order); !ok || err != nil {
+		t.Errorf("expected result of TopologicalSort to be a valid order, got %v (error: %v)", ok, err)
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ok, err := IsTopologicalOrder(g, test.order)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if ok != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, ok)
+			}
+		})
+	}
+}
+
+func TestUndirectedIsTopologicalOrder(t *testing.T) {
+	g := New(IntHash)
+
+	_ = g.AddVertex(1)
+
+	if _, err := IsTopologicalOrder(g, []int{1}); err == nil {
+		t.Errorf("expected error for undirected graph")
+	}
+}
+
+func TestTopologicalGenerations(t *testing.T) {
+	tests := map[string]struct {
+		traits              []func(*Traits)
+		vertices            []int
+		edges               []Edge[int]
+		expectedGenerations [][]int
+		shouldFail          bool
+	}{
+		"diamond with shortcut": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 1, Target: 4},
+				{Source: 6, Target: 3},
+			},
+			expectedGenerations: [][]int{{1, 5, 6}, {2, 3}, {4}},
+		},
+		"path": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 3, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expectedGenerations: [][]int{{3}, {2}, {1}},
+		},
+		"empty graph": {
+			traits:              []func(*Traits){Directed()},
+			expectedGenerations: [][]int{},
+		},
+		"graph with cycle": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 2},
+				{Source: 3, Target: 4},
+			},
+			shouldFail: true,
+		},
+		"undirected graph": {
+			traits:     []func(*Traits){},
+			vertices:   []int{1},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			generations, err := TopologicalGenerations(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if len(generations) != len(test.expectedGenerations) {
+				t.Fatalf("expected generations %v, got %v", test.expectedGenerations, generations)
+			}
+
+			order := make([]int, 0)
+
+			for i, generation := range generations {
+				if !slicesAreEqual(generation, test.expectedGenerations[i]) {
+					t.Errorf("expected generation %d to be %v, got %v", i, test.expectedGenerations[i], generation)
+				}
+				order = append(order, generation...)
+			}
+
+			if ok, _ := IsTopologicalOrder(g, order); !ok {
+				t.Errorf("expected concatenated generations to be a topological order, got %v", order)
+			}
+		})
+	}
+}
+
+func TestIsDAG(t *testing.T) {
+	tests := map[string]struct {
+		traits   *Traits
+		vertices []int
+		edges    []Edge[int]
+		expected bool
+	}{
+		"directed acyclic graph": {
+			traits:   &Traits{IsDirected: true},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expected: true,
+		},
+		"directed graph with cycle": {
+			traits:   &Traits{IsDirected: true},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+			},
+			expected: false,
+		},
+		"directed graph with self-loop": {
+			traits:   &Traits{IsDirected: true, AllowSelfLoops: true},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 2},
+			},
+			expected: false,
+		},
+		"empty directed graph": {
+			traits:   &Traits{IsDirected: true},
+			expected: true,
+		},
+		"undirected graph": {
+			traits:   &Traits{},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var g Graph[int, int]
+
+			if test.traits.IsDirected {
+				g = newDirected(IntHash, test.traits, newMemoryStore[int, int]())
+			} else {
+				g = newUndirected(IntHash, test.traits, newMemoryStore[int, int]())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			isDAG, err := IsDAG(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if isDAG != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, isDAG)
+			}
+		})
+	}
+}
+
+func TestFeedbackArcSet(t *testing.T) {
+	tests := map[string]struct {
+		traits       *Traits
+		vertices     []int
+		edges        []Edge[int]
+		expectedSize int
+		shouldFail   bool
+	}{
+		"directed acyclic graph": {
+			traits:   &Traits{IsDirected: true},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expectedSize: 0,
+		},
+		"directed graph with a single cycle": {
+			traits:   &Traits{IsDirected: true},
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 2},
+				{Source: 4, Target: 5},
+			},
+			expectedSize: 1,
+		},
+		"directed graph with two disjoint cycles": {
+			traits:   &Traits{IsDirected: true},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expectedSize: 2,
+		},
+		"directed graph with self-loop": {
+			traits:   &Traits{IsDirected: true, AllowSelfLoops: true},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 2},
+			},
+			expectedSize: 1,
+		},
+		"directed graph with overlapping cycles": {
+			traits:   &Traits{IsDirected: true},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 2, Target: 1},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 3},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 1},
+				{Source: 6, Target: 4},
+			},
+			expectedSize: -1,
+		},
+		"undirected graph": {
+			traits:   &Traits{},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var g Graph[int, int]
+
+			if test.traits.IsDirected {
+				g = newDirected(IntHash, test.traits, newMemoryStore[int, int]())
+			} else {
+				g = newUndirected(IntHash, test.traits, newMemoryStore[int, int]())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			feedbackArcs, err := FeedbackArcSet(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			// A size of -1 means that the size of the feedback arc set isn't
+			// checked, only that removing it yields a DAG.
+			if test.expectedSize >= 0 && len(feedbackArcs) != test.expectedSize {
+				t.Errorf("expected %d edges, got %d: %v", test.expectedSize, len(feedbackArcs), feedbackArcs)
+			}
+
+			for _, edge := range feedbackArcs {
+				if err := g.RemoveEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to remove edge (%v, %v): %s", edge.Source, edge.Target, err.Error())
+				}
+			}
+
+			isDAG, _ := IsDAG(g)
+			if !isDAG {
+				t.Errorf("expected graph to be a DAG after removing %v", feedbackArcs)
+			}
+
+			if _, err := TopologicalSort(g); err != nil {
+				t.Errorf("expected topological sort to succeed: %s", err.Error())
+			}
+		})
+	}
+}
+
 func TestDirectedTransitiveReduction(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []string
@@ -283,6 +682,82 @@ func TestDirectedTransitiveReduction(t *testing.T) {
 	}
 }
 
+func TestDirectedTransitiveReduction_largerDAG(t *testing.T) {
+	g := New(StringHash, Directed())
+
+	for _, vertex := range []string{"A", "B", "C", "D", "E", "F", "G", "H"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	edges := []Edge[string]{
+		{Source: "A", Target: "B"},
+		{Source: "A", Target: "C"},
+		{Source: "A", Target: "D"},
+		{Source: "A", Target: "E"},
+		{Source: "A", Target: "F"},
+		{Source: "A", Target: "G"},
+		{Source: "B", Target: "C"},
+		{Source: "B", Target: "D"},
+		{Source: "B", Target: "E"},
+		{Source: "C", Target: "D"},
+		{Source: "C", Target: "E"},
+		{Source: "D", Target: "E"},
+		{Source: "F", Target: "E"},
+		{Source: "F", Target: "G"},
+		{Source: "G", Target: "E"},
+		{Source: "H", Target: "E"},
+		{Source: "H", Target: "G"},
+	}
+
+	for _, edge := range edges {
+		if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+			t.Fatalf("failed to add edge: %s", err.Error())
+		}
+	}
+
+	expectedEdges := []Edge[string]{
+		{Source: "A", Target: "B"},
+		{Source: "A", Target: "F"},
+		{Source: "B", Target: "C"},
+		{Source: "C", Target: "D"},
+		{Source: "D", Target: "E"},
+		{Source: "F", Target: "G"},
+		{Source: "G", Target: "E"},
+		{Source: "H", Target: "G"},
+	}
+
+	var firstAdjacencyMap map[string]map[string]Edge[string]
+
+	for i := 0; i < 10; i++ {
+		reduction, err := TransitiveReduction(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+
+		actualEdges, _ := reduction.Edges()
+		equalsFunc := reduction.(*directed[string, string]).edgesAreEqual
+
+		if !slicesAreEqualWithFunc(actualEdges, expectedEdges, equalsFunc) {
+			t.Fatalf("expected edges %v, got %v", expectedEdges, actualEdges)
+		}
+
+		adjacencyMap, _ := reduction.AdjacencyMap()
+
+		if firstAdjacencyMap == nil {
+			firstAdjacencyMap = adjacencyMap
+			continue
+		}
+
+		if !adjacencyMapsAreEqual(firstAdjacencyMap, adjacencyMap, equalsFunc) {
+			t.Errorf("expected adjacency map %v, got %v in run %d", firstAdjacencyMap, adjacencyMap, i)
+		}
+	}
+
+	if size, _ := g.Size(); size != len(edges) {
+		t.Errorf("expected original graph to keep %d edges, got %d", len(edges), size)
+	}
+}
+
 func TestUndirectedTransitiveReduction(t *testing.T) {
 	tests := map[string]struct {
 		shouldFail bool
@@ -322,3 +797,113 @@ func slicesAreEqualWithFunc[T any](a, b []T, equals func(a, b T) bool) bool {
 
 	return true
 }
+
+func TestWeightedTransitiveClosure(t *testing.T) {
+	tests := map[string]struct {
+		traits          []func(*Traits)
+		vertices        []string
+		edges           []Edge[string]
+		expectedClosure map[string]map[string]float64
+		expectedError   error
+	}{
+		"directed weighted graph": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedClosure: map[string]map[string]float64{
+				"A": {"B": 3, "C": 1, "D": 8},
+				"B": {"D": 5},
+				"C": {"B": 2, "D": 7},
+				"D": {},
+			},
+		},
+		"directed graph with cycle and negative weight": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: -1}},
+				{Source: "C", Target: "A", Properties: EdgeProperties{Weight: 2}},
+			},
+			expectedClosure: map[string]map[string]float64{
+				"A": {"A": 4, "B": 3, "C": 2},
+				"B": {"A": 1, "B": 4, "C": -1},
+				"C": {"A": 2, "B": 5, "C": 4},
+			},
+		},
+		"unweighted graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 10}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 10}},
+			},
+			expectedClosure: map[string]map[string]float64{
+				"A": {"B": 1, "C": 2},
+				"B": {"C": 1},
+				"C": {},
+			},
+		},
+		"negative cycle": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "A", Properties: EdgeProperties{Weight: -2}},
+			},
+			expectedError: ErrNegativeCycle,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			closure, err := WeightedTransitiveClosure(g)
+
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf("expected error %v, got %v", test.expectedError, err)
+			}
+
+			if test.expectedError != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(closure, test.expectedClosure) {
+				t.Errorf("expected closure %v, got %v", test.expectedClosure, closure)
+			}
+
+			// The weights of all pairs of distinct vertices have to match the
+			// distances computed by the single-source shortest path algorithms.
+			for source, targets := range closure {
+				for target, weight := range targets {
+					if source == target {
+						continue
+					}
+					distance, err := Distance(g, source, target)
+					if err != nil {
+						t.Fatalf("failed to get distance from %v to %v: %s", source, target, err.Error())
+					}
+					if distance != weight {
+						t.Errorf("expected weight %v from %v to %v, got %v", distance, source, target, weight)
+					}
+				}
+			}
+		})
+	}
+}
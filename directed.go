@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -33,7 +34,12 @@ func (d *directed[K, T]) AddVertex(value T, options ...func(*VertexProperties))
 		option(&properties)
 	}
 
-	return d.store.AddVertex(hash, value, properties)
+	err := d.store.AddVertex(hash, value, properties)
+	if d.traits.AllowDuplicateAdd && errors.Is(err, ErrVertexAlreadyExists) {
+		return nil
+	}
+
+	return err
 }
 
 func (d *directed[K, T]) AddVerticesFrom(g Graph[K, T]) error {
@@ -75,6 +81,18 @@ func (d *directed[K, T]) RemoveVertex(hash K) error {
 }
 
 func (d *directed[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
+	// Adding an existing edge is a no-op if the user allowed duplicates. This
+	// check has to happen before the cycle check, which would fail otherwise.
+	if d.traits.AllowDuplicateAdd {
+		if _, err := d.store.Edge(sourceHash, targetHash); err == nil {
+			return nil
+		}
+	}
+
+	if sourceHash == targetHash && !d.traits.AllowSelfLoops {
+		return &SelfLoopError[K]{Key: sourceHash}
+	}
+
 	// If the user opted in to preventing cycles, run a cycle check.
 	if d.traits.PreventCycles {
 		createsCycle, err := d.createsCycle(sourceHash, targetHash)
@@ -107,8 +125,17 @@ func (d *directed[K, T]) AddEdgesFrom(g Graph[K, T]) error {
 		return fmt.Errorf("failed to get edges: %w", err)
 	}
 
-	for _, edge := range edges {
-		if err := d.AddEdge(copyEdge(edge)); err != nil {
+	if edges, err = validateEdges[K, T](d, edges); err != nil {
+		return err
+	}
+
+	for i, edge := range edges {
+		if err := d.addEdge(edge.Source, edge.Target, copiedEdge(edge)); err != nil {
+			// Roll back the edges that have already been added to keep the
+			// operation atomic, even if the store fails unexpectedly.
+			for _, addedEdge := range edges[:i] {
+				_ = d.store.RemoveEdge(addedEdge.Source, addedEdge.Target)
+			}
 			return fmt.Errorf("failed to add (%v, %v): %w", edge.Source, edge.Target, err)
 		}
 	}
@@ -169,6 +196,13 @@ func (d *directed[K, T]) RemoveEdge(source, target K) error {
 }
 
 func (d *directed[K, T]) AdjacencyMap() (map[K]map[K]Edge[K], error) {
+	// If the underlying store implements AdjacencyMap, use that fast path.
+	if am, ok := d.store.(interface {
+		AdjacencyMap() (map[K]map[K]Edge[K], error)
+	}); ok {
+		return am.AdjacencyMap()
+	}
+
 	vertices, err := d.store.ListVertices()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list vertices: %w", err)
@@ -193,6 +227,13 @@ func (d *directed[K, T]) AdjacencyMap() (map[K]map[K]Edge[K], error) {
 }
 
 func (d *directed[K, T]) PredecessorMap() (map[K]map[K]Edge[K], error) {
+	// If the underlying store implements PredecessorMap, use that fast path.
+	if pm, ok := d.store.(interface {
+		PredecessorMap() (map[K]map[K]Edge[K], error)
+	}); ok {
+		return pm.PredecessorMap()
+	}
+
 	vertices, err := d.store.ListVertices()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list vertices: %w", err)
@@ -225,11 +266,13 @@ func (d *directed[K, T]) addEdge(sourceHash, targetHash K, edge Edge[K]) error {
 
 func (d *directed[K, T]) Clone() (Graph[K, T], error) {
 	traits := &Traits{
-		IsDirected:    d.traits.IsDirected,
-		IsAcyclic:     d.traits.IsAcyclic,
-		IsWeighted:    d.traits.IsWeighted,
-		IsRooted:      d.traits.IsRooted,
-		PreventCycles: d.traits.PreventCycles,
+		IsDirected:        d.traits.IsDirected,
+		IsAcyclic:         d.traits.IsAcyclic,
+		IsWeighted:        d.traits.IsWeighted,
+		IsRooted:          d.traits.IsRooted,
+		PreventCycles:     d.traits.PreventCycles,
+		AllowDuplicateAdd: d.traits.AllowDuplicateAdd,
+		AllowSelfLoops:    d.traits.AllowSelfLoops,
 	}
 
 	clone := &directed[K, T]{
@@ -298,3 +341,101 @@ func copyEdge[K comparable](edge Edge[K]) (K, K, func(properties *EdgeProperties
 
 	return edge.Source, edge.Target, copyProperties
 }
+
+// copiedEdge returns a copy of the given edge that doesn't share its attribute
+// map with the original edge.
+func copiedEdge[K comparable](edge Edge[K]) Edge[K] {
+	_, _, copyProperties := copyEdge(edge)
+
+	copied := Edge[K]{
+		Source: edge.Source,
+		Target: edge.Target,
+		Properties: EdgeProperties{
+			Attributes: make(map[string]string),
+		},
+	}
+
+	copyProperties(&copied.Properties)
+
+	return copied
+}
+
+func (d *directed[K, T]) shortestPaths(source K, edgeWeight func(Edge[K]) float64) (map[K]float64, map[K]K, error) {
+	// If the underlying store implements ShortestPaths, use that fast path.
+	if sp, ok := d.store.(interface {
+		ShortestPaths(source K, edgeWeight func(Edge[K]) float64) (map[K]float64, map[K]K, error)
+	}); ok {
+		return sp.ShortestPaths(source, edgeWeight)
+	}
+
+	// Slow path.
+	return adjacencyMapDijkstra[K, T](d, source, edgeWeight)
+}
+
+func (d *directed[K, T]) degrees() (map[K]int, error) {
+	// If the underlying store implements Degrees, use that fast path.
+	if ds, ok := d.store.(interface {
+		Degrees() (map[K]int, map[K]int, error)
+	}); ok {
+		outDegrees, inDegrees, err := ds.Degrees()
+		if err != nil {
+			return nil, err
+		}
+		for vertex, inDegree := range inDegrees {
+			outDegrees[vertex] += inDegree
+		}
+		return outDegrees, nil
+	}
+
+	// Slow path.
+	return adjacencyMapDegrees[K, T](d)
+}
+
+func (d *directed[K, T]) cachedAdjacencyMap() (map[K]map[K]Edge[K], error) {
+	// If the underlying store implements CachedAdjacencyMap, use that fast path.
+	if cs, ok := d.store.(interface {
+		CachedAdjacencyMap() (map[K]map[K]Edge[K], error)
+	}); ok {
+		return cs.CachedAdjacencyMap()
+	}
+
+	// Slow path.
+	return d.AdjacencyMap()
+}
+
+func (d *directed[K, T]) cachedPredecessorMap() (map[K]map[K]Edge[K], error) {
+	// If the underlying store implements CachedPredecessorMap, use that fast
+	// path.
+	if cs, ok := d.store.(interface {
+		CachedPredecessorMap() (map[K]map[K]Edge[K], error)
+	}); ok {
+		return cs.CachedPredecessorMap()
+	}
+
+	// Slow path.
+	return d.PredecessorMap()
+}
+
+func (d *directed[K, T]) removeEdges(edges []Edge[K], ignoreMissing bool) error {
+	// If the underlying store implements RemoveEdges, use that fast path.
+	if rs, ok := d.store.(interface {
+		RemoveEdges(edges []Edge[K], ignoreMissing bool) error
+	}); ok {
+		return rs.RemoveEdges(edges, ignoreMissing)
+	}
+
+	// Slow path.
+	return removeEdgesOneByOne[K, T](d, edges, ignoreMissing)
+}
+
+func (d *directed[K, T]) clear() error {
+	// If the underlying store implements Clear, use that fast path.
+	if cs, ok := d.store.(interface {
+		Clear() error
+	}); ok {
+		return cs.Clear()
+	}
+
+	// Slow path.
+	return clearOneByOne[K, T](d)
+}
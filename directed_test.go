@@ -599,6 +599,166 @@ func TestDirected_AddEdgesFrom(t *testing.T) {
 	}
 }
 
+func TestDirected_AllowDuplicateAdd(t *testing.T) {
+	g := New(IntHash, Directed(), PreventCycles(), AllowDuplicateAdd())
+
+	_ = g.AddVertex(1, VertexWeight(10))
+	_ = g.AddVertex(2)
+
+	if err := g.AddVertex(1, VertexWeight(20)); err != nil {
+		t.Fatalf("expected duplicate vertex to be accepted, got %v", err)
+	}
+
+	if _, properties, _ := g.VertexWithProperties(1); properties.Weight != 10 {
+		t.Errorf("expected existing vertex weight 10 to be kept, got %v", properties.Weight)
+	}
+
+	_ = g.AddEdge(1, 2, EdgeWeight(5))
+
+	// Adding the edge again must neither fail due to the duplicate edge nor due
+	// to the cycle check.
+	if err := g.AddEdge(1, 2, EdgeWeight(7)); err != nil {
+		t.Fatalf("expected duplicate edge to be accepted, got %v", err)
+	}
+
+	if edge, _ := g.Edge(1, 2); edge.Properties.Weight != 5 {
+		t.Errorf("expected existing edge weight 5 to be kept, got %v", edge.Properties.Weight)
+	}
+
+	if err := g.AddEdge(1, 3); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+
+	source := New(IntHash, Directed())
+	_ = source.AddVertex(1)
+	_ = source.AddVertex(2)
+	_ = source.AddVertex(3)
+	_ = source.AddEdge(1, 2)
+	_ = source.AddEdge(2, 3)
+
+	_ = g.AddVertex(3)
+
+	if err := g.AddEdgesFrom(source); err != nil {
+		t.Fatalf("expected overlapping edges to be accepted, got %v", err)
+	}
+
+	if size, _ := g.Size(); size != 2 {
+		t.Errorf("expected 2 edges, got %d", size)
+	}
+}
+
+func TestDirected_AddEdgesFrom_concurrentAdd(t *testing.T) {
+	source := New(IntHash, Directed())
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = source.AddVertex(vertex)
+	}
+
+	_ = source.AddEdge(1, 2)
+	_ = source.AddEdge(2, 3)
+
+	store := &concurrentAddStore[int, int]{
+		Store: newMemoryStore[int, int](),
+		edge:  Edge[int]{Source: 2, Target: 3},
+	}
+	g := NewWithStore[int, int](IntHash, store, Directed())
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = g.AddVertex(vertex)
+	}
+
+	if err := g.AddEdgesFrom(source); !errors.Is(err, ErrEdgeAlreadyExists) {
+		t.Fatalf("expected error %v, got %v", ErrEdgeAlreadyExists, err)
+	}
+
+	if _, err := g.Edge(2, 3); err != nil {
+		t.Errorf("expected concurrently added edge to be kept, got %v", err)
+	}
+
+	if _, err := g.Edge(1, 2); !errors.Is(err, ErrEdgeNotFound) {
+		t.Errorf("expected added edge to be rolled back, got %v", err)
+	}
+}
+
+func TestDirected_AddEdgesFrom_atomic(t *testing.T) {
+	tests := map[string]struct {
+		traits           []func(*Traits)
+		edges            []Edge[int]
+		existingVertices []int
+		existingEdges    []Edge[int]
+		expectedError    error
+	}{
+		"edge with non-existing vertex": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			existingVertices: []int{1, 2, 3},
+			expectedError:    ErrVertexNotFound,
+		},
+		"one duplicated edge": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			existingVertices: []int{1, 2, 3, 4},
+			existingEdges: []Edge[int]{
+				{Source: 2, Target: 3},
+			},
+			expectedError: ErrEdgeAlreadyExists,
+		},
+		"edges creating a cycle": {
+			traits: []func(*Traits){Directed(), PreventCycles()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			existingVertices: []int{1, 2, 3},
+			expectedError:    ErrEdgeCreatesCycle,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			source := New(IntHash, Directed())
+
+			for _, vertex := range []int{1, 2, 3, 4} {
+				_ = source.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = source.AddEdge(copyEdge(edge))
+			}
+
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.existingVertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.existingEdges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			err := g.AddEdgesFrom(source)
+
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf("expected error %v, got %v", test.expectedError, err)
+			}
+
+			size, _ := g.Size()
+			if size != len(test.existingEdges) {
+				t.Errorf("expected %d edges after failed insertion, got %d", len(test.existingEdges), size)
+			}
+		})
+	}
+}
+
 func TestDirected_Edge(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []int
@@ -1447,3 +1607,43 @@ func predecessors[K comparable, T any](g *directed[K, T], vertexHash K) ([]K, er
 
 	return predecessorHashes, nil
 }
+
+func TestDirected_AllowSelfLoops(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		expectedError error
+	}{
+		"self-loops not allowed": {
+			traits:        []func(*Traits){Directed()},
+			expectedError: ErrSelfLoop,
+		},
+		"self-loops allowed": {
+			traits:        []func(*Traits){Directed(), AllowSelfLoops()},
+			expectedError: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+			_ = g.AddVertex(1)
+
+			err := g.AddEdge(1, 1)
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf("expected error %v, got %v", test.expectedError, err)
+			}
+
+			source := New(IntHash, Directed(), AllowSelfLoops())
+			_ = source.AddVertex(1)
+			_ = source.AddEdge(1, 1)
+
+			target := New(IntHash, test.traits...)
+			_ = target.AddVertex(1)
+
+			err = target.AddEdgesFrom(source)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected error %v when adding edges, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
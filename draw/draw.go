@@ -1,11 +1,14 @@
 // Package draw provides functions for visualizing graph structures. At this
 // time, draw supports the DOT language which can be interpreted by Graphviz,
-// Grappa, and others.
+// Grappa, and others, as well as GML, which can be read by Cytoscape and other
+// graph tools.
 package draw
 
 import (
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"text/template"
 
 	"github.com/dominikbraun/graph"
@@ -14,10 +17,18 @@ import (
 // ToDo: This template should be simplified and split into multiple templates.
 const dotTemplate = `strict {{.GraphType}} {
 {{range $k, $v := .Attributes -}}
-	{{$k}}="{{$v}}";
+	"{{escape $k}}"="{{escape $v}}";
+{{- end}}
+{{- range $c := .Clusters}}
+	subgraph "cluster_{{escape $c.Name}}" {
+		label="{{escape $c.Name}}";
+{{- range $s := $c.Statements}}
+		"{{escape .Source}}" [ {{range $k, $v := .SourceAttributes}}"{{escape $k}}"="{{escape $v}}", {{end}} weight={{.SourceWeight}} ];
+{{- end}}
+	}
 {{- end}}
 {{- range $s := .Statements}}
-	"{{.Source}}" {{if .Target}}{{$.EdgeOperator}} "{{.Target}}" [ {{range $k, $v := .EdgeAttributes}}{{$k}}="{{$v}}", {{end}} weight={{.EdgeWeight}} ]{{else}}[ {{range $k, $v := .SourceAttributes}}{{$k}}="{{$v}}", {{end}} weight={{.SourceWeight}} ]{{end}};
+	"{{escape .Source}}" {{if .Target}}{{$.EdgeOperator}} "{{escape .Target}}" [ {{range $k, $v := .EdgeAttributes}}"{{escape $k}}"="{{escape $v}}", {{end}} weight={{.EdgeWeight}} ]{{else}}[ {{range $k, $v := .SourceAttributes}}"{{escape $k}}"="{{escape $v}}", {{end}} weight={{.SourceWeight}} ]{{end}};
 {{- end}}
 {{- range $s := .ExtraStatements}}
 	{{$s}}
@@ -30,7 +41,20 @@ type Description struct {
 	Attributes      map[string]string
 	EdgeOperator    string
 	Statements      []Statement
+	Clusters        []Cluster
 	ExtraStatements []string
+
+	// clusterOf returns the name of the cluster for a given vertex hash. It is
+	// set using the WithClusters functional option.
+	clusterOf func(interface{}) string
+}
+
+// Cluster is a group of vertices that is rendered as a Graphviz cluster, i.e.
+// as a subgraph whose name is prefixed with "cluster_". Its statements only
+// describe vertices, the edges remain part of the top-level statements.
+type Cluster struct {
+	Name       string
+	Statements []Statement
 }
 
 type Statement struct {
@@ -74,6 +98,8 @@ type Statement struct {
 // add global attributes when rendering the graph:
 //
 //	_ = draw.DOT(g, file, draw.GraphAttribute("label", "my-graph"))
+//
+// To group vertices into Graphviz clusters, use the [WithClusters] option.
 func DOT[K comparable, T any](g graph.Graph[K, T], w io.Writer, options ...func(*Description)) error {
 	desc, err := generateDOT(g, options...)
 	if err != nil {
@@ -90,6 +116,30 @@ func GraphAttribute(key, value string) func(*Description) {
 	}
 }
 
+// WithClusters is a functional option for the [DOT] method that groups the
+// vertices into clusters. The given function returns the cluster name for a
+// vertex hash, and all vertices with the same cluster name are rendered within
+// the same Graphviz cluster. Vertices for which an empty string is returned
+// don't belong to any cluster.
+//
+//	_ = draw.DOT(g, file, draw.WithClusters(func(vertex string) string {
+//		return strings.Split(vertex, "/")[0]
+//	}))
+//
+// The type K has to match the hash type of the rendered graph. Otherwise, the
+// function isn't called and none of the vertices belong to a cluster.
+func WithClusters[K comparable](cluster func(K) string) func(*Description) {
+	return func(d *Description) {
+		d.clusterOf = func(vertex interface{}) string {
+			k, ok := vertex.(K)
+			if !ok {
+				return ""
+			}
+			return cluster(k)
+		}
+	}
+}
+
 func generateDOT[K comparable, T any](g graph.Graph[K, T], options ...func(*Description)) (Description, error) {
 	desc := Description{
 		GraphType:    "graph",
@@ -112,6 +162,8 @@ func generateDOT[K comparable, T any](g graph.Graph[K, T], options ...func(*Desc
 		return desc, err
 	}
 
+	clusters := make(map[string][]Statement)
+
 	for vertex, adjacencies := range adjacencyMap {
 		_, sourceProperties, err := g.VertexWithProperties(vertex)
 		if err != nil {
@@ -123,7 +175,17 @@ func generateDOT[K comparable, T any](g graph.Graph[K, T], options ...func(*Desc
 			SourceWeight:     sourceProperties.Weight,
 			SourceAttributes: sourceProperties.Attributes,
 		}
-		desc.Statements = append(desc.Statements, stmt)
+
+		var cluster string
+		if desc.clusterOf != nil {
+			cluster = desc.clusterOf(vertex)
+		}
+
+		if cluster != "" {
+			clusters[cluster] = append(clusters[cluster], stmt)
+		} else {
+			desc.Statements = append(desc.Statements, stmt)
+		}
 
 		for adjacency, edge := range adjacencies {
 			stmt := Statement{
@@ -136,14 +198,41 @@ func generateDOT[K comparable, T any](g graph.Graph[K, T], options ...func(*Desc
 		}
 	}
 
+	names := make([]string, 0, len(clusters))
+	for name := range clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		desc.Clusters = append(desc.Clusters, Cluster{
+			Name:       name,
+			Statements: clusters[name],
+		})
+	}
+
 	return desc, nil
 }
 
 func renderDOT(w io.Writer, d Description) error {
-	tpl, err := template.New("dotTemplate").Parse(dotTemplate)
+	funcs := template.FuncMap{
+		"escape": escape,
+	}
+
+	tpl, err := template.New("dotTemplate").Funcs(funcs).Parse(dotTemplate)
 	if err != nil {
 		return fmt.Errorf("failed to parse template: %w", err)
 	}
 
 	return tpl.Execute(w, d)
 }
+
+// escaper escapes all characters that would terminate or break a quoted DOT
+// identifier, namely backslashes, double quotes, and line breaks.
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`)
+
+// escape formats the given value and escapes it so that it can be used within
+// a quoted DOT identifier such as a vertex hash or an attribute value.
+func escape(value interface{}) string {
+	return escaper.Replace(fmt.Sprint(value))
+}
@@ -269,8 +269,8 @@ func TestRenderDOT(t *testing.T) {
 				},
 			},
 			expected: `strict digraph {
-				"1" -> "2" [ color="red", weight=0 ];
-				"1" -> "3" [ color="blue", weight=0 ];
+				"1" -> "2" [ "color"="red", weight=0 ];
+				"1" -> "3" [ "color"="blue", weight=0 ];
 				"1" [ weight=0 ];
 				"2" [ weight=0 ];
 				"3" [ weight=0 ];
@@ -320,11 +320,61 @@ func TestRenderDOT(t *testing.T) {
 				},
 			},
 			expected: `strict digraph {
-				"1" [ color="red", weight=10 ];
-				"2" [ color="blue", weight=20 ];
+				"1" [ "color"="red", weight=10 ];
+				"2" [ "color"="blue", weight=20 ];
 				"1" -> "2" [ weight=0 ];
 			}`,
 		},
+		"vertices and attributes requiring escaping": {
+			description: Description{
+				GraphType:    "digraph",
+				Attributes:   map[string]string{},
+				EdgeOperator: "->",
+				Statements: []Statement{
+					{
+						Source: `my "quoted" file`,
+						SourceAttributes: map[string]string{
+							"label":    "line 1\nline 2",
+							`my "key"`: "value",
+						},
+					},
+					{Source: `my "quoted" file`, Target: `C:\dir`},
+				},
+			},
+			expected: `strict digraph {
+				"my \"quoted\" file" [ "label"="line 1\nline 2", "my \"key\""="value", weight=0 ];
+				"my \"quoted\" file" -> "C:\\dir" [ weight=0 ];
+			}`,
+		},
+		"vertices in clusters": {
+			description: Description{
+				GraphType:    "digraph",
+				Attributes:   map[string]string{},
+				EdgeOperator: "->",
+				Clusters: []Cluster{
+					{
+						Name: "scc-1",
+						Statements: []Statement{
+							{Source: 1},
+							{Source: 2},
+						},
+					},
+				},
+				Statements: []Statement{
+					{Source: 1, Target: 2},
+					{Source: 3},
+				},
+			},
+			expected: `strict digraph {
+				subgraph "cluster_scc-1" {
+					label="scc-1";
+					"1" [ weight=0 ];
+					"2" [ weight=0 ];
+				}
+				"1" -> "2" [ weight=0 ];
+				"3" [ weight=0 ];
+			}`,
+		},
 		"3-vertex directed graph with attributes": {
 			description: Description{
 				GraphType: "digraph",
@@ -341,7 +391,7 @@ func TestRenderDOT(t *testing.T) {
 				},
 			},
 			expected: `strict digraph {
-				label="my-graph";
+				"label"="my-graph";
 				"1" -> "2" [ weight=0 ];
 				"1" -> "3" [ weight=0 ];
 				"1" [ weight=0 ];
@@ -364,6 +414,78 @@ func TestRenderDOT(t *testing.T) {
 	}
 }
 
+func TestGenerateDOT_WithClusters(t *testing.T) {
+	g := graph.New(graph.StringHash, graph.Directed())
+
+	for _, vertex := range []string{"a/1", "a/2", "b/1", "c"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge("a/1", "a/2")
+	_ = g.AddEdge("a/2", "b/1")
+
+	desc, err := generateDOT(g, WithClusters(func(vertex string) string {
+		if i := strings.Index(vertex, "/"); i >= 0 {
+			return vertex[:i]
+		}
+		return ""
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expectedClusters := []Cluster{
+		{Name: "a", Statements: []Statement{{Source: "a/1"}, {Source: "a/2"}}},
+		{Name: "b", Statements: []Statement{{Source: "b/1"}}},
+	}
+
+	if len(desc.Clusters) != len(expectedClusters) {
+		t.Fatalf("expected %d clusters, got %d", len(expectedClusters), len(desc.Clusters))
+	}
+
+	for i, expected := range expectedClusters {
+		if desc.Clusters[i].Name != expected.Name {
+			t.Errorf("expected cluster %v at index %d, got %v", expected.Name, i, desc.Clusters[i].Name)
+		}
+		if !slicesAreEqual(desc.Clusters[i].Statements, expected.Statements, statementsAreEqual) {
+			t.Errorf("expected statements %v for cluster %v, got %v", expected.Statements, expected.Name, desc.Clusters[i].Statements)
+		}
+	}
+
+	expectedStatements := []Statement{
+		{Source: "c"},
+		{Source: "a/1", Target: "a/2"},
+		{Source: "a/2", Target: "b/1"},
+	}
+
+	if !slicesAreEqual(desc.Statements, expectedStatements, statementsAreEqual) {
+		t.Errorf("expected statements %v, got %v", expectedStatements, desc.Statements)
+	}
+}
+
+func TestGenerateDOT_WithClustersMismatchedKeyType(t *testing.T) {
+	g := graph.New(graph.IntHash, graph.Directed())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2)
+
+	desc, err := generateDOT(g, WithClusters(func(vertex string) string {
+		return vertex
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if len(desc.Clusters) != 0 {
+		t.Errorf("expected no clusters, got %v", desc.Clusters)
+	}
+
+	if len(desc.Statements) != 3 {
+		t.Errorf("expected 3 statements, got %d", len(desc.Statements))
+	}
+}
+
 func TestGraphAttribute(t *testing.T) {
 	tests := map[string]struct {
 		attribute [2]string
@@ -0,0 +1,177 @@
+package draw
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dominikbraun/graph"
+)
+
+// reservedGMLKeys are the keys written by GML for each node or edge, which
+// therefore can't be used as attribute keys.
+var reservedGMLKeys = map[string]struct{}{
+	"id":     {},
+	"source": {},
+	"target": {},
+	"weight": {},
+}
+
+// GML renders the given graph structure in the Graph Modelling Language into an
+// io.Writer, for example a file. GML is understood by Cytoscape and other graph
+// tools.
+//
+// Each vertex becomes a node with a numeric ID, its hash as label, its weight,
+// and its attributes, and each edge becomes an edge with its weight and
+// attributes. A "label" attribute replaces the default label. Each edge of an
+// undirected graph is rendered only once. If an attribute key isn't a valid GML
+// key or is one of id, source, target, and weight, an error will be returned.
+func GML[K comparable, T any](g graph.Graph[K, T], w io.Writer) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return fmt.Sprint(vertices[i]) < fmt.Sprint(vertices[j])
+	})
+
+	ids := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		ids[vertex] = i
+	}
+
+	var buf bytes.Buffer
+
+	directed := 0
+	if g.Traits().IsDirected {
+		directed = 1
+	}
+
+	fmt.Fprintf(&buf, "graph [\n\tdirected %d\n", directed)
+
+	for _, vertex := range vertices {
+		_, properties, err := g.VertexWithProperties(vertex)
+		if err != nil {
+			return fmt.Errorf("failed to get vertex %v: %w", vertex, err)
+		}
+
+		buf.WriteString("\tnode [\n")
+		fmt.Fprintf(&buf, "\t\tid %d\n", ids[vertex])
+
+		if err := writeGMLProperties(&buf, vertex, properties.Weight, properties.Attributes); err != nil {
+			return fmt.Errorf("failed to write vertex %v: %w", vertex, err)
+		}
+
+		buf.WriteString("\t]\n")
+	}
+
+	for _, vertex := range vertices {
+		adjacencies := make([]K, 0, len(adjacencyMap[vertex]))
+		for adjacency := range adjacencyMap[vertex] {
+			// An undirected edge is contained in the adjacency map twice, but
+			// is only rendered from the vertex with the lower ID.
+			if !g.Traits().IsDirected && ids[adjacency] < ids[vertex] {
+				continue
+			}
+			adjacencies = append(adjacencies, adjacency)
+		}
+
+		sort.Slice(adjacencies, func(i, j int) bool {
+			return ids[adjacencies[i]] < ids[adjacencies[j]]
+		})
+
+		for _, adjacency := range adjacencies {
+			edge := adjacencyMap[vertex][adjacency]
+
+			buf.WriteString("\tedge [\n")
+			fmt.Fprintf(&buf, "\t\tsource %d\n\t\ttarget %d\n", ids[vertex], ids[adjacency])
+
+			label := fmt.Sprintf("%v -> %v", vertex, adjacency)
+			if !g.Traits().IsDirected {
+				label = fmt.Sprintf("%v -- %v", vertex, adjacency)
+			}
+
+			if err := writeGMLProperties(&buf, label, edge.Properties.Weight, edge.Properties.Attributes); err != nil {
+				return fmt.Errorf("failed to write edge (%v, %v): %w", vertex, adjacency, err)
+			}
+
+			buf.WriteString("\t]\n")
+		}
+	}
+
+	buf.WriteString("]\n")
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write GML: %w", err)
+	}
+
+	return nil
+}
+
+// writeGMLProperties writes the label, the weight, and the sorted attributes of
+// a node or edge. A label attribute replaces the given default label.
+func writeGMLProperties(buf *bytes.Buffer, label interface{}, weight int, attributes map[string]string) error {
+	keys := make([]string, 0, len(attributes))
+
+	for key := range attributes {
+		if !isValidGMLKey(key) {
+			return fmt.Errorf("attribute key %q is not a valid GML key", key)
+		}
+		if _, ok := reservedGMLKeys[key]; ok {
+			return fmt.Errorf("attribute key %q is reserved", key)
+		}
+		if key == "label" {
+			label = attributes[key]
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	fmt.Fprintf(buf, "\t\tlabel \"%s\"\n", escapeGML(label))
+	fmt.Fprintf(buf, "\t\tweight %d\n", weight)
+
+	for _, key := range keys {
+		fmt.Fprintf(buf, "\t\t%s \"%s\"\n", key, escapeGML(attributes[key]))
+	}
+
+	return nil
+}
+
+// isValidGMLKey determines whether the given key consists of ASCII letters and
+// digits and starts with a letter, as required by GML.
+func isValidGMLKey(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for i, r := range key {
+		isLetter := r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+		isDigit := r >= '0' && r <= '9'
+
+		if !isLetter && (i == 0 || !isDigit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// gmlEscaper escapes the characters that would terminate a GML string or be
+// mistaken for the start of a character entity.
+var gmlEscaper = strings.NewReplacer(`&`, `&amp;`, `"`, `&quot;`)
+
+// escapeGML formats the given value and escapes it so that it can be used
+// within a GML string.
+func escapeGML(value interface{}) string {
+	return gmlEscaper.Replace(fmt.Sprint(value))
+}
@@ -0,0 +1,153 @@
+package draw
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+func TestGML(t *testing.T) {
+	tests := map[string]struct {
+		graph            graph.Graph[string, string]
+		vertices         []string
+		vertexProperties map[string]graph.VertexProperties
+		edges            []graph.Edge[string]
+		expected         string
+		shouldFail       bool
+	}{
+		"directed graph": {
+			graph:    graph.New(graph.StringHash, graph.Directed()),
+			vertices: []string{"B", "A", "C"},
+			edges: []graph.Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "C", Target: "A"},
+			},
+			expected: `graph [
+				directed 1
+				node [ id 0 label "A" weight 0 ]
+				node [ id 1 label "B" weight 0 ]
+				node [ id 2 label "C" weight 0 ]
+				edge [ source 0 target 1 label "A -> B" weight 0 ]
+				edge [ source 2 target 0 label "C -> A" weight 0 ]
+			]`,
+		},
+		"undirected graph with each edge rendered once": {
+			graph:    graph.New(graph.StringHash),
+			vertices: []string{"A", "B", "C"},
+			edges: []graph.Edge[string]{
+				{Source: "B", Target: "A"},
+				{Source: "B", Target: "C"},
+			},
+			expected: `graph [
+				directed 0
+				node [ id 0 label "A" weight 0 ]
+				node [ id 1 label "B" weight 0 ]
+				node [ id 2 label "C" weight 0 ]
+				edge [ source 0 target 1 label "A -- B" weight 0 ]
+				edge [ source 1 target 2 label "B -- C" weight 0 ]
+			]`,
+		},
+		"weights and attributes": {
+			graph:    graph.New(graph.StringHash, graph.Directed(), graph.Weighted()),
+			vertices: []string{"A", "B"},
+			vertexProperties: map[string]graph.VertexProperties{
+				"A": {
+					Weight: 3,
+					Attributes: map[string]string{
+						"shape": "box",
+						"color": "red",
+					},
+				},
+				"B": {
+					Attributes: map[string]string{
+						"label": `say "hi" & go`,
+					},
+				},
+			},
+			edges: []graph.Edge[string]{
+				{
+					Source: "A",
+					Target: "B",
+					Properties: graph.EdgeProperties{
+						Weight: 7,
+						Attributes: map[string]string{
+							"label": "road",
+						},
+					},
+				},
+			},
+			expected: `graph [
+				directed 1
+				node [ id 0 label "A" weight 3 color "red" shape "box" ]
+				node [ id 1 label "say &quot;hi&quot; &amp; go" weight 0 ]
+				edge [ source 0 target 1 label "road" weight 7 ]
+			]`,
+		},
+		"invalid attribute key": {
+			graph:    graph.New(graph.StringHash),
+			vertices: []string{"A"},
+			vertexProperties: map[string]graph.VertexProperties{
+				"A": {
+					Attributes: map[string]string{
+						"font-size": "12",
+					},
+				},
+			},
+			shouldFail: true,
+		},
+		"reserved attribute key": {
+			graph:    graph.New(graph.StringHash),
+			vertices: []string{"A", "B"},
+			edges: []graph.Edge[string]{
+				{
+					Source: "A",
+					Target: "B",
+					Properties: graph.EdgeProperties{
+						Attributes: map[string]string{
+							"source": "X",
+						},
+					},
+				},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, vertex := range test.vertices {
+				properties := test.vertexProperties[vertex]
+				_ = test.graph.AddVertex(vertex, func(p *graph.VertexProperties) {
+					p.Weight = properties.Weight
+					p.Attributes = properties.Attributes
+				})
+			}
+
+			for _, edge := range test.edges {
+				if err := test.graph.AddEdge(edge.Source, edge.Target, graph.EdgeWeight(edge.Properties.Weight), graph.EdgeAttributes(edge.Properties.Attributes)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			var buf bytes.Buffer
+
+			err := GML(test.graph, &buf)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			output := normalizeOutput(buf.String())
+			expected := normalizeOutput(test.expected)
+
+			if output != expected {
+				t.Errorf("expected GML\n%v\ngot\n%v", expected, output)
+			}
+		})
+	}
+}
@@ -31,6 +31,19 @@ type (
 	EdgeCausesCycleError[K comparable] struct {
 		Source, Target K
 	}
+
+	// SelfLoopError is returned by AddEdge when the given source and target
+	// are the same vertex and the graph doesn't have the AllowSelfLoops trait.
+	SelfLoopError[K comparable] struct {
+		Key K
+	}
+
+	// NegativeCycleError is returned by shortest path algorithms that detect a
+	// negative-weight cycle. Cycle contains the vertices forming the cycle in
+	// edge direction, where the first vertex is repeated at the end.
+	NegativeCycleError[K comparable] struct {
+		Cycle []K
+	}
 )
 
 func (e *VertexAlreadyExistsError[K, T]) Error() string {
@@ -57,6 +70,14 @@ func (e *EdgeCausesCycleError[K]) Error() string {
 	return fmt.Sprintf("edge %v - %v would cause a cycle", e.Source, e.Target)
 }
 
+func (e *SelfLoopError[K]) Error() string {
+	return fmt.Sprintf("edge %v - %v is a self-loop, which requires the AllowSelfLoops trait", e.Key, e.Key)
+}
+
+func (e *NegativeCycleError[K]) Error() string {
+	return fmt.Sprintf("graph contains a negative-weight cycle %v", e.Cycle)
+}
+
 var (
 	ErrVertexNotFound      = errors.New("vertex not found")
 	ErrVertexAlreadyExists = errors.New("vertex already exists")
@@ -64,6 +85,8 @@ var (
 	ErrEdgeAlreadyExists   = errors.New("edge already exists")
 	ErrEdgeCreatesCycle    = errors.New("edge would create a cycle")
 	ErrVertexHasEdges      = errors.New("vertex has edges")
+	ErrNegativeCycle       = errors.New("graph contains a negative-weight cycle")
+	ErrSelfLoop            = errors.New("self-loops are not allowed")
 )
 
 func (e *VertexAlreadyExistsError[K, T]) Unwrap() error { return ErrVertexAlreadyExists }
@@ -72,3 +95,5 @@ func (e *EdgeAlreadyExistsError[K]) Unwrap() error      { return ErrEdgeAlreadyE
 func (e *EdgeNotFoundError[K]) Unwrap() error           { return ErrEdgeNotFound }
 func (e *VertexHasEdgesError[K]) Unwrap() error         { return ErrVertexHasEdges }
 func (e *EdgeCausesCycleError[K]) Unwrap() error        { return ErrEdgeCreatesCycle }
+func (e *NegativeCycleError[K]) Unwrap() error          { return ErrNegativeCycle }
+func (e *SelfLoopError[K]) Unwrap() error               { return ErrSelfLoop }
@@ -0,0 +1,423 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+)
+
+// CanonicalForm computes a canonical form of the given graph, which is a byte
+// representation of the graph's structure that doesn't depend on the hashes of
+// its vertices. It contains the directedness of the graph, the number of its
+// vertices, and all edges between the canonical labels along with their weights.
+//
+// The canonical labels are determined using color refinement, also known as the
+// one-dimensional Weisfeiler-Lehman algorithm. Vertices that still share a color
+// once the coloring is stable are told apart by picking the smallest of them as
+// per keyLess. Graphs with equal canonical forms are always isomorphic, but for
+// highly symmetric graphs, two isomorphic graphs might produce different forms.
+func CanonicalForm[K comparable, T any](g Graph[K, T], keyLess func(a, b K) bool) ([]byte, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	// Sorting the vertices by their keys makes the individualization of
+	// vertices deterministic.
+	sort.Slice(vertices, func(i, j int) bool {
+		return keyLess(vertices[i], vertices[j])
+	})
+
+	refinement := newColorRefinement(g, adjacencyMap, vertices)
+	colors := refinement.refine(make([]int, len(vertices)))
+
+	for {
+		individual, ok := refinement.smallestSharedColor(colors)
+		if !ok {
+			break
+		}
+
+		individualized := make([]int, len(colors))
+		for i, color := range colors {
+			individualized[i] = 2*color + 1
+		}
+		individualized[individual] = 2 * colors[individual]
+
+		colors = refinement.refine(individualized)
+	}
+
+	// Once each vertex has a color of its own, the colors are the canonical
+	// labels 0 to n-1.
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "directed=%v\n", g.Traits().IsDirected)
+	fmt.Fprintf(&buf, "vertices=%d\n", len(vertices))
+
+	lines := make([][3]int, 0)
+
+	for i, neighbors := range refinement.outEdges {
+		for _, neighbor := range neighbors {
+			source, target := colors[i], colors[neighbor.index]
+
+			// An undirected edge is contained in the adjacency lists of both
+			// vertices, but only needs to be serialized once.
+			if !g.Traits().IsDirected && source > target {
+				continue
+			}
+
+			lines = append(lines, [3]int{source, target, neighbor.weight})
+		}
+	}
+
+	sort.Slice(lines, func(i, j int) bool {
+		return intsLess(lines[i][:], lines[j][:])
+	})
+
+	for _, line := range lines {
+		fmt.Fprintf(&buf, "%d %d %d\n", line[0], line[1], line[2])
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WLHash computes Weisfeiler-Lehman hashes for the vertices of the given graph
+// along with an aggregate hash of the entire graph. The hash of a vertex
+// describes the structure of its neighborhood up to the given number of hops.
+// Isomorphic graphs always produce the same aggregate hash, but graphs with the
+// same hash are not guaranteed to be isomorphic. Vertex values and properties
+// other than the edge weights are not taken into account.
+func WLHash[K comparable, T any](g Graph[K, T], iterations int) (map[K]uint64, uint64, error) {
+	if iterations < 0 {
+		return nil, 0, errors.New("number of iterations must not be negative")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	refinement := newColorRefinement(g, adjacencyMap, vertices)
+	hashes := make([]uint64, len(vertices))
+
+	for i := 0; i < iterations; i++ {
+		hashes = refinement.hashStep(hashes)
+	}
+
+	vertexHashes := make(map[K]uint64, len(vertices))
+	for i, vertex := range vertices {
+		vertexHashes[vertex] = hashes[i]
+	}
+
+	sorted := make([]uint64, len(hashes))
+	copy(sorted, hashes)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	var directed uint64
+	if g.Traits().IsDirected {
+		directed = 1
+	}
+
+	graphHash := hashUint64s(append([]uint64{directed, uint64(len(sorted))}, sorted...))
+
+	return vertexHashes, graphHash, nil
+}
+
+// Fingerprint computes a hash of the given graph's structure, consisting of its
+// directedness, its vertex hashes, and its edges along with their weights. The
+// fingerprint doesn't depend on the order in which vertices and edges have been
+// added. In contrast to [WLHash], isomorphic graphs with different vertex hashes
+// have different fingerprints. Different graphs might produce the same
+// fingerprint with a low probability.
+func Fingerprint[K comparable, T any](g Graph[K, T]) (uint64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	vertexHashes := make(map[K]uint64, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertexHashes[vertex] = hashString(fmt.Sprint(vertex))
+	}
+
+	vertices := make([]uint64, 0, len(vertexHashes))
+	for _, hash := range vertexHashes {
+		vertices = append(vertices, hash)
+	}
+
+	edgeHashes := make([]uint64, 0, len(edges))
+	for _, edge := range edges {
+		source, target := vertexHashes[edge.Source], vertexHashes[edge.Target]
+
+		// An undirected edge must have the same hash regardless of which
+		// vertex is its source.
+		if !g.Traits().IsDirected && source > target {
+			source, target = target, source
+		}
+
+		edgeHashes = append(edgeHashes, hashUint64s([]uint64{source, target, uint64(edge.Properties.Weight)}))
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return vertices[i] < vertices[j]
+	})
+
+	sort.Slice(edgeHashes, func(i, j int) bool {
+		return edgeHashes[i] < edgeHashes[j]
+	})
+
+	var directed uint64
+	if g.Traits().IsDirected {
+		directed = 1
+	}
+
+	values := []uint64{directed, uint64(len(vertices))}
+	values = append(values, vertices...)
+	values = append(values, uint64(len(edgeHashes)))
+	values = append(values, edgeHashes...)
+
+	return hashUint64s(values), nil
+}
+
+// colorRefinement holds the adjacencies of a graph, where each vertex has been
+// replaced with its index in the vertices slice that has been used to create
+// the colorRefinement.
+type colorRefinement struct {
+	directed bool
+	outEdges [][]indexedEdge
+	inEdges  [][]indexedEdge
+}
+
+// indexedEdge is an edge leading to the vertex with the given index.
+type indexedEdge struct {
+	index  int
+	weight int
+}
+
+func newColorRefinement[K comparable, T any](g Graph[K, T], adjacencyMap map[K]map[K]Edge[K], vertices []K) *colorRefinement {
+	indices := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		indices[vertex] = i
+	}
+
+	c := &colorRefinement{
+		directed: g.Traits().IsDirected,
+		outEdges: make([][]indexedEdge, len(vertices)),
+		inEdges:  make([][]indexedEdge, len(vertices)),
+	}
+
+	for i, vertex := range vertices {
+		for adjacency, edge := range adjacencyMap[vertex] {
+			j := indices[adjacency]
+			c.outEdges[i] = append(c.outEdges[i], indexedEdge{index: j, weight: edge.Properties.Weight})
+			c.inEdges[j] = append(c.inEdges[j], indexedEdge{index: i, weight: edge.Properties.Weight})
+		}
+	}
+
+	return c
+}
+
+// refine refines the given coloring until it is stable, i.e. until the colors of
+// the neighbors don't tell apart any vertices of the same color anymore. The
+// returned colors are the integers 0 to k-1 for k distinct colors and are only
+// determined by the structure of the graph and the given coloring.
+func (c *colorRefinement) refine(colors []int) []int {
+	colors = c.step(colors)
+
+	for {
+		refined := c.step(colors)
+		if countColors(refined) == countColors(colors) {
+			return refined
+		}
+		colors = refined
+	}
+}
+
+// step performs a single refinement step, assigning each vertex a new color
+// based on its current color and the colors of its neighbors.
+func (c *colorRefinement) step(colors []int) []int {
+	signatures := make([][]int, len(colors))
+
+	for i, color := range colors {
+		signature := []int{color}
+		signature = append(signature, neighborColors(c.outEdges[i], colors)...)
+
+		if c.directed {
+			// The in-neighbors are separated from the out-neighbors by a marker
+			// that can't be a color.
+			signature = append(signature, -1)
+			signature = append(signature, neighborColors(c.inEdges[i], colors)...)
+		}
+
+		signatures[i] = signature
+	}
+
+	return rankSignatures(signatures)
+}
+
+// hashStep performs a single Weisfeiler-Lehman iteration, assigning each vertex
+// a new hash based on its current hash and the hashes of its neighbors.
+func (c *colorRefinement) hashStep(hashes []uint64) []uint64 {
+	result := make([]uint64, len(hashes))
+
+	for i, hash := range hashes {
+		values := []uint64{hash}
+		values = append(values, neighborHashes(c.outEdges[i], hashes)...)
+
+		if c.directed {
+			// Since the hashes of the neighbors are preceded by their number,
+			// the out-neighbors can't be mistaken for the in-neighbors.
+			values = append(values, neighborHashes(c.inEdges[i], hashes)...)
+		}
+
+		result[i] = hashUint64s(values)
+	}
+
+	return result
+}
+
+// smallestSharedColor returns the first vertex with the smallest color that is
+// shared with other vertices. If each vertex has a color of its own, false is
+// returned.
+func (c *colorRefinement) smallestSharedColor(colors []int) (int, bool) {
+	counts := make(map[int]int, len(colors))
+	for _, color := range colors {
+		counts[color]++
+	}
+
+	individual, found := 0, false
+
+	for i, color := range colors {
+		if counts[color] < 2 {
+			continue
+		}
+		if !found || color < colors[individual] {
+			individual, found = i, true
+		}
+	}
+
+	return individual, found
+}
+
+// neighborColors returns the sorted colors of the given neighbors, each one
+// followed by the weight of the edge leading to it.
+func neighborColors(neighbors []indexedEdge, colors []int) []int {
+	pairs := make([][2]int, len(neighbors))
+	for i, neighbor := range neighbors {
+		pairs[i] = [2]int{colors[neighbor.index], neighbor.weight}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return intsLess(pairs[i][:], pairs[j][:])
+	})
+
+	result := make([]int, 0, 2*len(pairs))
+	for _, pair := range pairs {
+		result = append(result, pair[0], pair[1])
+	}
+
+	return result
+}
+
+// neighborHashes returns the number of the given neighbors followed by their
+// sorted hashes, each one combined with the weight of the edge leading to it.
+func neighborHashes(neighbors []indexedEdge, hashes []uint64) []uint64 {
+	result := make([]uint64, len(neighbors))
+	for i, neighbor := range neighbors {
+		result[i] = hashUint64s([]uint64{hashes[neighbor.index], uint64(neighbor.weight)})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return append([]uint64{uint64(len(result))}, result...)
+}
+
+// hashUint64s computes the 64-bit FNV-1a hash of the given values.
+func hashUint64s(values []uint64) uint64 {
+	hash := fnv.New64a()
+	buf := make([]byte, 8)
+
+	for _, value := range values {
+		binary.LittleEndian.PutUint64(buf, value)
+		_, _ = hash.Write(buf)
+	}
+
+	return hash.Sum64()
+}
+
+// hashString computes the 64-bit FNV-1a hash of the given string.
+func hashString(value string) uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(value))
+
+	return hash.Sum64()
+}
+
+// rankSignatures replaces each signature with its rank among all distinct
+// signatures, so that equal signatures obtain the same rank.
+func rankSignatures(signatures [][]int) []int {
+	sorted := make([][]int, len(signatures))
+	copy(sorted, signatures)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return intsLess(sorted[i], sorted[j])
+	})
+
+	ranks := make(map[string]int, len(sorted))
+	for _, signature := range sorted {
+		key := fmt.Sprint(signature)
+		if _, ok := ranks[key]; !ok {
+			ranks[key] = len(ranks)
+		}
+	}
+
+	result := make([]int, len(signatures))
+	for i, signature := range signatures {
+		result[i] = ranks[fmt.Sprint(signature)]
+	}
+
+	return result
+}
+
+// countColors returns the number of distinct colors.
+func countColors(colors []int) int {
+	distinct := make(map[int]struct{}, len(colors))
+	for _, color := range colors {
+		distinct[color] = struct{}{}
+	}
+
+	return len(distinct)
+}
+
+// intsLess compares two integer slices lexicographically.
+func intsLess(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+
+	return len(a) < len(b)
+}
@@ -0,0 +1,387 @@
+package graph
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCanonicalForm(t *testing.T) {
+	tests := map[string]struct {
+		traits       []func(*Traits)
+		edges        []Edge[int]
+		relabel      map[int]int
+		otherEdges   []Edge[int]
+		expectEqual  bool
+		expectedForm string
+	}{
+		"relabeled undirected graph": {
+			traits: []func(*Traits){Weighted()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 1}},
+				{Source: 4, Target: 1, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 5, Properties: EdgeProperties{Weight: 2}},
+			},
+			relabel:     map[int]int{1: 50, 2: 10, 3: 40, 4: 20, 5: 30},
+			expectEqual: true,
+		},
+		"relabeled directed graph": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			relabel:     map[int]int{1: 5, 2: 4, 3: 3, 4: 2, 5: 1},
+			expectEqual: true,
+		},
+		"relabeled cycle": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 1},
+			},
+			relabel:      map[int]int{1: 3, 2: 6, 3: 2, 4: 5, 5: 1, 6: 4},
+			expectEqual:  true,
+			expectedForm: "directed=false\nvertices=6\n0 1 0\n0 2 0\n1 3 0\n2 4 0\n3 5 0\n4 5 0\n",
+		},
+		"different graphs": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expectEqual: false,
+		},
+		"different edge weights": {
+			traits: []func(*Traits){Weighted()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 2}},
+			},
+			expectEqual: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+			h := New(IntHash, test.traits...)
+
+			otherEdges := test.otherEdges
+			if test.relabel != nil {
+				otherEdges = make([]Edge[int], len(test.edges))
+				for i, edge := range test.edges {
+					otherEdges[i] = Edge[int]{
+						Source:     test.relabel[edge.Source],
+						Target:     test.relabel[edge.Target],
+						Properties: edge.Properties,
+					}
+				}
+			}
+
+			addEdgesWithVertices(t, g, test.edges)
+			addEdgesWithVertices(t, h, otherEdges)
+
+			form, err := CanonicalForm(g, keyLess[int])
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			otherForm, err := CanonicalForm(h, keyLess[int])
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if equal := bytes.Equal(form, otherForm); equal != test.expectEqual {
+				t.Errorf("expected equal forms == %v, got %q and %q", test.expectEqual, form, otherForm)
+			}
+
+			if test.expectedForm != "" && string(form) != test.expectedForm {
+				t.Errorf("expected form %q, got %q", test.expectedForm, form)
+			}
+		})
+	}
+}
+
+func TestWLHash(t *testing.T) {
+	tests := map[string]struct {
+		traits      []func(*Traits)
+		edges       []Edge[int]
+		otherEdges  []Edge[int]
+		iterations  int
+		expectEqual bool
+		shouldFail  bool
+	}{
+		"isomorphic undirected graphs": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 40, Target: 10},
+				{Source: 10, Target: 30},
+				{Source: 30, Target: 20},
+				{Source: 20, Target: 10},
+			},
+			iterations:  3,
+			expectEqual: true,
+		},
+		"isomorphic directed graphs": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 7, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 7, Target: 8},
+			},
+			iterations:  3,
+			expectEqual: true,
+		},
+		"path and star": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			iterations:  2,
+			expectEqual: false,
+		},
+		"reversed directed edges": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 2, Target: 1},
+				{Source: 3, Target: 1},
+			},
+			iterations:  1,
+			expectEqual: false,
+		},
+		"different edge weights": {
+			traits: []func(*Traits){Weighted()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 2}},
+			},
+			iterations:  1,
+			expectEqual: false,
+		},
+		"negative number of iterations": {
+			traits:     []func(*Traits){},
+			iterations: -1,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+			h := New(IntHash, test.traits...)
+
+			addEdgesWithVertices(t, g, test.edges)
+			addEdgesWithVertices(t, h, test.otherEdges)
+
+			vertexHashes, hash, err := WLHash(g, test.iterations)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			otherVertexHashes, otherHash, err := WLHash(h, test.iterations)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if (hash == otherHash) != test.expectEqual {
+				t.Errorf("expected equal hashes == %v, got %v and %v", test.expectEqual, hash, otherHash)
+			}
+
+			if len(vertexHashes) != len(otherVertexHashes) {
+				t.Fatalf("expected %d vertex hashes, got %d", len(otherVertexHashes), len(vertexHashes))
+			}
+
+			if !test.expectEqual {
+				return
+			}
+
+			hashes := make([]uint64, 0, len(vertexHashes))
+			for _, vertexHash := range vertexHashes {
+				hashes = append(hashes, vertexHash)
+			}
+
+			otherHashes := make([]uint64, 0, len(otherVertexHashes))
+			for _, vertexHash := range otherVertexHashes {
+				otherHashes = append(otherHashes, vertexHash)
+			}
+
+			if !slicesAreEqual(hashes, otherHashes) {
+				t.Errorf("expected vertex hashes %v, got %v", otherHashes, hashes)
+			}
+		})
+	}
+}
+
+// addEdgesWithVertices adds the given edges to the graph along with all vertices
+// they join.
+func TestFingerprint(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		edges         []Edge[int]
+		otherEdges    []Edge[int]
+		otherVertices []int
+		expectEqual   bool
+	}{
+		"same undirected graph in different insertion order": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expectEqual: true,
+		},
+		"same directed graph in different insertion order": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 3, Target: 4},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 2},
+			},
+			expectEqual: true,
+		},
+		"reversed directed edge": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 2, Target: 1},
+			},
+			expectEqual: false,
+		},
+		"isomorphic graphs with different vertex hashes": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 4},
+			},
+			expectEqual: false,
+		},
+		"different edge weights": {
+			traits: []func(*Traits){Weighted()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 2}},
+			},
+			expectEqual: false,
+		},
+		"additional isolated vertex": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			otherEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			otherVertices: []int{3},
+			expectEqual:   false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+			h := New(IntHash, test.traits...)
+
+			addEdgesWithVertices(t, g, test.edges)
+			addEdgesWithVertices(t, h, test.otherEdges)
+
+			for _, vertex := range test.otherVertices {
+				_ = h.AddVertex(vertex)
+			}
+
+			fingerprint, err := Fingerprint(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			otherFingerprint, err := Fingerprint(h)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if (fingerprint == otherFingerprint) != test.expectEqual {
+				t.Errorf("expected equal fingerprints == %v, got %v and %v", test.expectEqual, fingerprint, otherFingerprint)
+			}
+
+			// The fingerprint of an unchanged graph must remain the same.
+			if again, _ := Fingerprint(g); again != fingerprint {
+				t.Errorf("expected fingerprint %v on the second call, got %v", fingerprint, again)
+			}
+		})
+	}
+}
+
+func addEdgesWithVertices(t *testing.T, g Graph[int, int], edges []Edge[int]) {
+	for _, edge := range edges {
+		_ = g.AddVertex(edge.Source)
+		_ = g.AddVertex(edge.Target)
+
+		if err := g.AddEdge(copyEdge(edge)); err != nil {
+			t.Fatalf("failed to add edge: %s", err.Error())
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// ResidualGraph builds the residual graph for the given flow through g, which is
+// a directed, weighted graph where the weight of each edge denotes its residual
+// capacity. For each edge (u,v) in g, it contains a forward edge (u,v) with a
+// weight of capacity minus flow and a backward edge (v,u) with a weight of flow,
+// summing up opposite edges and omitting self-loops and edges without residual
+// capacity.
+//
+// flow maps a source vertex to its target vertices and the amount of flow along
+// the edge joining them. If capacity is nil, the edge weights are used. A flow
+// that is negative or exceeds the capacity of its edge results in an error.
+func ResidualGraph[K comparable, T any](g Graph[K, T], flow map[K]map[K]int, capacity func(Edge[K]) int) (Graph[K, T], error) {
+	if capacity == nil {
+		capacity = func(edge Edge[K]) int {
+			return edge.Properties.Weight
+		}
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	residual := New(hashOf(g), Directed(), Weighted())
+	capacities := make(map[K]map[K]int, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		if err = residual.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+
+		capacities[hash] = make(map[K]int)
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			c, f := capacity(edge), flow[source][target]
+			if f < 0 {
+				return nil, fmt.Errorf("flow %d along (%v, %v) is negative", f, source, target)
+			}
+			if f > c {
+				return nil, fmt.Errorf("flow %d along (%v, %v) exceeds capacity %d", f, source, target, c)
+			}
+			// A self-loop can't carry any flow from the source to the sink, so
+			// it isn't part of the residual graph.
+			if source == target {
+				continue
+			}
+			capacities[source][target] += c - f
+			capacities[target][source] += f
+		}
+	}
+
+	for source, targets := range capacities {
+		for target, c := range targets {
+			if c <= 0 {
+				continue
+			}
+			if err := residual.AddEdge(source, target, EdgeWeight(c)); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+			}
+		}
+	}
+
+	return residual, nil
+}
+
+// SolveAssignment solves the assignment problem for the given workers and tasks
+// using min-cost flow. A worker can only be assigned to a task that it is joined
+// with by an edge, at the cost returned by the cost function. The returned
+// matching has the maximum possible number of assignments, and among those, the
+// lowest total cost.
+func SolveAssignment[K comparable, T any](g Graph[K, T], workers, tasks []K, cost func(worker, task K) float64) (map[K]K, float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for _, vertex := range append(append([]K{}, workers...), tasks...) {
+		if _, ok := adjacencyMap[vertex]; !ok {
+			return nil, 0, &VertexNotFoundError[K]{Key: vertex}
+		}
+	}
+
+	// The network consists of a source vertex with index 0, the workers, the
+	// tasks, and a sink vertex with the last index.
+	source, sink := 0, len(workers)+len(tasks)+1
+	network := newFlowNetwork(sink + 1)
+
+	for i := range workers {
+		network.addEdge(source, i+1, 1, 0)
+	}
+
+	for j := range tasks {
+		network.addEdge(len(workers)+j+1, sink, 1, 0)
+	}
+
+	for i, worker := range workers {
+		for j, task := range tasks {
+			if _, ok := adjacencyMap[worker][task]; !ok {
+				continue
+			}
+			network.addEdge(i+1, len(workers)+j+1, 1, cost(worker, task))
+		}
+	}
+
+	_, totalCost := network.minCostMaxFlow(source, sink)
+
+	assignment := make(map[K]K)
+
+	for i, worker := range workers {
+		for _, e := range network.adjacencies[i+1] {
+			to := network.edges[e].to
+			if to <= len(workers) || to == sink || network.edges[e].capacity > 0 {
+				continue
+			}
+			assignment[worker] = tasks[to-len(workers)-1]
+		}
+	}
+
+	return assignment, totalCost, nil
+}
+
+// flowNetwork is an index-based flow network used for computing min-cost flows.
+// Each edge is stored along with its reverse edge, which is located at the
+// subsequent index in the edges slice.
+type flowNetwork struct {
+	adjacencies [][]int
+	edges       []flowEdge
+}
+
+type flowEdge struct {
+	to       int
+	capacity int
+	cost     float64
+}
+
+func newFlowNetwork(n int) *flowNetwork {
+	return &flowNetwork{
+		adjacencies: make([][]int, n),
+	}
+}
+
+func (f *flowNetwork) addEdge(from, to, capacity int, cost float64) {
+	f.adjacencies[from] = append(f.adjacencies[from], len(f.edges))
+	f.edges = append(f.edges, flowEdge{to: to, capacity: capacity, cost: cost})
+	f.adjacencies[to] = append(f.adjacencies[to], len(f.edges))
+	f.edges = append(f.edges, flowEdge{to: from, capacity: 0, cost: -cost})
+}
+
+// minCostMaxFlow computes a maximum flow with minimum cost from source to sink
+// using successive shortest paths. Because the residual edges may have negative
+// costs, the shortest paths are determined using Bellman-Ford.
+func (f *flowNetwork) minCostMaxFlow(source, sink int) (int, float64) {
+	const epsilon = 1e-9
+
+	n := len(f.adjacencies)
+	totalFlow, totalCost := 0, 0.0
+
+	for {
+		dist := make([]float64, n)
+		prevEdge := make([]int, n)
+		for i := range dist {
+			dist[i] = math.Inf(1)
+			prevEdge[i] = -1
+		}
+		dist[source] = 0
+
+		for i := 0; i < n-1; i++ {
+			updated := false
+			for u := 0; u < n; u++ {
+				if math.IsInf(dist[u], 1) {
+					continue
+				}
+				for _, e := range f.adjacencies[u] {
+					edge := f.edges[e]
+					if edge.capacity > 0 && dist[u]+edge.cost < dist[edge.to]-epsilon {
+						dist[edge.to] = dist[u] + edge.cost
+						prevEdge[edge.to] = e
+						updated = true
+					}
+				}
+			}
+			if !updated {
+				break
+			}
+		}
+
+		if math.IsInf(dist[sink], 1) {
+			return totalFlow, totalCost
+		}
+
+		bottleneck := math.MaxInt
+		for v := sink; v != source; v = f.edges[prevEdge[v]^1].to {
+			if c := f.edges[prevEdge[v]].capacity; c < bottleneck {
+				bottleneck = c
+			}
+		}
+
+		for v := sink; v != source; v = f.edges[prevEdge[v]^1].to {
+			f.edges[prevEdge[v]].capacity -= bottleneck
+			f.edges[prevEdge[v]^1].capacity += bottleneck
+		}
+
+		totalFlow += bottleneck
+		totalCost += float64(bottleneck) * dist[sink]
+	}
+}
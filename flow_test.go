@@ -0,0 +1,247 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDirectedResidualGraph(t *testing.T) {
+	tests := map[string]struct {
+		vertices          []string
+		edges             []Edge[string]
+		flow              map[string]map[string]int
+		expectedResiduals map[string]map[string]int
+		shouldFail        bool
+	}{
+		"saturated edge": {
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 5}},
+			},
+			flow: map[string]map[string]int{
+				"A": {"B": 3},
+				"B": {"C": 3},
+			},
+			expectedResiduals: map[string]map[string]int{
+				"A": {},
+				"B": {"A": 3, "C": 2},
+				"C": {"B": 3},
+			},
+		},
+		"no flow": {
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+			},
+			flow: map[string]map[string]int{},
+			expectedResiduals: map[string]map[string]int{
+				"A": {"B": 3},
+				"B": {},
+			},
+		},
+		"antiparallel edges": {
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 2}},
+			},
+			flow: map[string]map[string]int{
+				"A": {"B": 1},
+			},
+			expectedResiduals: map[string]map[string]int{
+				"A": {"B": 2},
+				"B": {"A": 3},
+			},
+		},
+		"flow exceeding capacity": {
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+			},
+			flow: map[string]map[string]int{
+				"A": {"B": 4},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, Directed(), Weighted())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			residual, err := ResidualGraph(g, test.flow, nil)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if !residual.Traits().IsDirected {
+				t.Errorf("expected residual graph to be directed")
+			}
+
+			adjacencyMap, _ := residual.AdjacencyMap()
+
+			for source, targets := range test.expectedResiduals {
+				if len(adjacencyMap[source]) != len(targets) {
+					t.Errorf("expected %d residual edges for %v, got %d", len(targets), source, len(adjacencyMap[source]))
+				}
+				for target, expectedWeight := range targets {
+					edge, ok := adjacencyMap[source][target]
+					if !ok {
+						t.Errorf("expected residual edge (%v, %v)", source, target)
+						continue
+					}
+					if edge.Properties.Weight != expectedWeight {
+						t.Errorf("expected residual capacity %d for (%v, %v), got %d", expectedWeight, source, target, edge.Properties.Weight)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResidualGraph_saturatedEdge(t *testing.T) {
+	g := New(StringHash, Directed(), Weighted())
+
+	_ = g.AddVertex("A")
+	_ = g.AddVertex("B")
+	_ = g.AddEdge("A", "B", EdgeWeight(4))
+
+	capacity := func(edge Edge[string]) int {
+		return edge.Properties.Weight
+	}
+
+	residual, err := ResidualGraph(g, map[string]map[string]int{"A": {"B": 4}}, capacity)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := residual.Edge("A", "B"); !errors.Is(err, ErrEdgeNotFound) {
+		t.Errorf("expected saturated edge to have no forward capacity, got %v", err)
+	}
+
+	backward, err := residual.Edge("B", "A")
+	if err != nil {
+		t.Fatalf("expected backward edge: %v", err)
+	}
+
+	if backward.Properties.Weight <= 0 {
+		t.Errorf("expected positive backward capacity, got %d", backward.Properties.Weight)
+	}
+}
+
+func TestResidualGraph_selfLoop(t *testing.T) {
+	g := New(StringHash, Directed(), Weighted(), AllowSelfLoops())
+
+	_ = g.AddVertex("A")
+	_ = g.AddVertex("B")
+	_ = g.AddEdge("A", "A", EdgeWeight(3))
+	_ = g.AddEdge("A", "B", EdgeWeight(4))
+
+	residual, err := ResidualGraph(g, map[string]map[string]int{"A": {"A": 1, "B": 2}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := residual.Edge("A", "A"); !errors.Is(err, ErrEdgeNotFound) {
+		t.Errorf("expected self-loop to be omitted, got %v", err)
+	}
+
+	forward, err := residual.Edge("A", "B")
+	if err != nil {
+		t.Fatalf("expected forward edge: %v", err)
+	}
+
+	if forward.Properties.Weight != 2 {
+		t.Errorf("expected forward capacity 2, got %d", forward.Properties.Weight)
+	}
+}
+
+func TestSolveAssignment(t *testing.T) {
+	costs := map[string]map[string]float64{
+		"W1": {"T1": 9, "T2": 2, "T3": 7},
+		"W2": {"T1": 6, "T2": 4, "T3": 3},
+		"W3": {"T1": 5, "T2": 8, "T3": 1},
+	}
+
+	tests := map[string]struct {
+		edges              []Edge[string]
+		expectedAssignment map[string]string
+		expectedCost       float64
+	}{
+		"complete 3x3 cost matrix": {
+			edges: []Edge[string]{
+				{Source: "W1", Target: "T1"}, {Source: "W1", Target: "T2"}, {Source: "W1", Target: "T3"},
+				{Source: "W2", Target: "T1"}, {Source: "W2", Target: "T2"}, {Source: "W2", Target: "T3"},
+				{Source: "W3", Target: "T1"}, {Source: "W3", Target: "T2"}, {Source: "W3", Target: "T3"},
+			},
+			expectedAssignment: map[string]string{"W1": "T2", "W2": "T1", "W3": "T3"},
+			expectedCost:       9,
+		},
+		"restricted assignments": {
+			edges: []Edge[string]{
+				{Source: "W1", Target: "T1"},
+				{Source: "W2", Target: "T2"}, {Source: "W2", Target: "T3"},
+				{Source: "W3", Target: "T3"},
+			},
+			expectedAssignment: map[string]string{"W1": "T1", "W2": "T2", "W3": "T3"},
+			expectedCost:       14,
+		},
+		"not every worker assignable": {
+			edges: []Edge[string]{
+				{Source: "W1", Target: "T1"},
+				{Source: "W2", Target: "T1"},
+			},
+			expectedAssignment: map[string]string{"W2": "T1"},
+			expectedCost:       6,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, Directed())
+
+			for _, vertex := range []string{"W1", "W2", "W3", "T1", "T2", "T3"} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			cost := func(worker, task string) float64 {
+				return costs[worker][task]
+			}
+
+			assignment, totalCost, err := SolveAssignment(g, []string{"W1", "W2", "W3"}, []string{"T1", "T2", "T3"}, cost)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !mapsAreEqual(test.expectedAssignment, assignment) || len(test.expectedAssignment) != len(assignment) {
+				t.Errorf("expected assignment %v, got %v", test.expectedAssignment, assignment)
+			}
+
+			if totalCost != test.expectedCost {
+				t.Errorf("expected cost %v, got %v", test.expectedCost, totalCost)
+			}
+		})
+	}
+}
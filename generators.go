@@ -0,0 +1,180 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// CompleteGraph fills the given graph with a complete graph of n vertices, where
+// each vertex is joined with every other vertex. The vertices are the integers
+// 0 to n-1. Like all generators, CompleteGraph adds the vertices and edges to
+// the given graph, which determines properties such as directedness and is
+// expected to be empty.
+func CompleteGraph(g Graph[int, int], n int) error {
+	if err := addGeneratedVertices(g, n); err != nil {
+		return err
+	}
+
+	for source := 0; source < n; source++ {
+		for target := 0; target < n; target++ {
+			if source == target || (!g.Traits().IsDirected && target < source) {
+				continue
+			}
+			if err := g.AddEdge(source, target); err != nil {
+				return fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CycleGraph fills the given graph with a cycle of n vertices, joining each
+// vertex i with the vertex i+1 and the last vertex with the first one. The
+// vertices are the integers 0 to n-1, and n has to be at least 3.
+func CycleGraph(g Graph[int, int], n int) error {
+	if n < 3 {
+		return fmt.Errorf("a cycle graph requires at least 3 vertices, got %d", n)
+	}
+
+	if err := PathGraph(g, n); err != nil {
+		return err
+	}
+
+	if err := g.AddEdge(n-1, 0); err != nil {
+		return fmt.Errorf("failed to add edge (%v, %v): %w", n-1, 0, err)
+	}
+
+	return nil
+}
+
+// PathGraph fills the given graph with a path of n vertices, joining each vertex
+// i with the vertex i+1. The vertices are the integers 0 to n-1.
+func PathGraph(g Graph[int, int], n int) error {
+	if err := addGeneratedVertices(g, n); err != nil {
+		return err
+	}
+
+	for i := 1; i < n; i++ {
+		if err := g.AddEdge(i-1, i); err != nil {
+			return fmt.Errorf("failed to add edge (%v, %v): %w", i-1, i, err)
+		}
+	}
+
+	return nil
+}
+
+// StarGraph fills the given graph with a star of n vertices, where the center
+// vertex 0 is joined with each of the remaining vertices 1 to n-1. In a directed
+// graph, the edges point away from the center.
+func StarGraph(g Graph[int, int], n int) error {
+	if err := addGeneratedVertices(g, n); err != nil {
+		return err
+	}
+
+	for i := 1; i < n; i++ {
+		if err := g.AddEdge(0, i); err != nil {
+			return fmt.Errorf("failed to add edge (%v, %v): %w", 0, i, err)
+		}
+	}
+
+	return nil
+}
+
+// GridGraph fills the given graph with a two-dimensional grid of the given size,
+// where each vertex is joined with its right and its lower neighbor. The vertex
+// in row r and column c is the integer r*cols+c. In a directed graph, the edges
+// point to the right and downwards.
+func GridGraph(g Graph[int, int], rows, cols int) error {
+	if rows < 0 || cols < 0 {
+		return fmt.Errorf("grid dimensions must not be negative, got %dx%d", rows, cols)
+	}
+
+	if err := addGeneratedVertices(g, rows*cols); err != nil {
+		return err
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			vertex := r*cols + c
+
+			if c+1 < cols {
+				if err := g.AddEdge(vertex, vertex+1); err != nil {
+					return fmt.Errorf("failed to add edge (%v, %v): %w", vertex, vertex+1, err)
+				}
+			}
+
+			if r+1 < rows {
+				if err := g.AddEdge(vertex, vertex+cols); err != nil {
+					return fmt.Errorf("failed to add edge (%v, %v): %w", vertex, vertex+cols, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// GenerateScaleFree fills the given graph with a random scale-free graph of n
+// vertices using the Barabási-Albert preferential attachment model. Starting
+// with m vertices, each additional vertex is joined with m distinct existing
+// vertices, preferring vertices with a higher degree. The vertices are the
+// integers 0 to n-1, and in a directed graph, the edges point to the existing
+// vertices. The same seed of the given random number generator always produces
+// the same graph. m has to be at least 1 and must not exceed n.
+func GenerateScaleFree(g Graph[int, int], n, m int, rng *rand.Rand) error {
+	if m < 1 || m > n {
+		return fmt.Errorf("m must be between 1 and n (%d), got %d", n, m)
+	}
+
+	if err := addGeneratedVertices(g, n); err != nil {
+		return err
+	}
+
+	// Each vertex is contained in repeatedVertices once per edge it is joined
+	// with, so that picking a random element is proportional to the degree.
+	repeatedVertices := make([]int, 0, 2*(n-m)*m)
+
+	targets := make([]int, m)
+	for i := range targets {
+		targets[i] = i
+	}
+
+	for source := m; source < n; source++ {
+		for _, target := range targets {
+			if err := g.AddEdge(source, target); err != nil {
+				return fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+			}
+			repeatedVertices = append(repeatedVertices, target, source)
+		}
+
+		chosen := make(map[int]struct{}, m)
+		targets = targets[:0]
+
+		for len(targets) < m {
+			target := repeatedVertices[rng.Intn(len(repeatedVertices))]
+			if _, ok := chosen[target]; ok {
+				continue
+			}
+			chosen[target] = struct{}{}
+			targets = append(targets, target)
+		}
+	}
+
+	return nil
+}
+
+// addGeneratedVertices adds the vertices 0 to n-1 to the given graph.
+func addGeneratedVertices(g Graph[int, int], n int) error {
+	if n < 0 {
+		return fmt.Errorf("number of vertices must not be negative, got %d", n)
+	}
+
+	for i := 0; i < n; i++ {
+		if err := g.AddVertex(i); err != nil {
+			return fmt.Errorf("failed to add vertex %v: %w", i, err)
+		}
+	}
+
+	return nil
+}
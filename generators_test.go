@@ -0,0 +1,193 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGenerators(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		generate      func(g Graph[int, int]) error
+		expectedOrder int
+		expectedSize  int
+		expectedEdges []Edge[int]
+		shouldFail    bool
+	}{
+		"undirected complete graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return CompleteGraph(g, 4)
+			},
+			expectedOrder: 4,
+			expectedSize:  6,
+			expectedEdges: []Edge[int]{{Source: 3, Target: 0}},
+		},
+		"directed complete graph": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				return CompleteGraph(g, 4)
+			},
+			expectedOrder: 4,
+			expectedSize:  12,
+			expectedEdges: []Edge[int]{{Source: 0, Target: 3}, {Source: 3, Target: 0}},
+		},
+		"cycle graph": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				return CycleGraph(g, 5)
+			},
+			expectedOrder: 5,
+			expectedSize:  5,
+			expectedEdges: []Edge[int]{{Source: 0, Target: 1}, {Source: 4, Target: 0}},
+		},
+		"too small cycle graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return CycleGraph(g, 2)
+			},
+			shouldFail: true,
+		},
+		"path graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return PathGraph(g, 5)
+			},
+			expectedOrder: 5,
+			expectedSize:  4,
+			expectedEdges: []Edge[int]{{Source: 0, Target: 1}, {Source: 4, Target: 3}},
+		},
+		"star graph": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				return StarGraph(g, 5)
+			},
+			expectedOrder: 5,
+			expectedSize:  4,
+			expectedEdges: []Edge[int]{{Source: 0, Target: 1}, {Source: 0, Target: 4}},
+		},
+		"grid graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return GridGraph(g, 2, 3)
+			},
+			expectedOrder: 6,
+			expectedSize:  7,
+			expectedEdges: []Edge[int]{{Source: 0, Target: 1}, {Source: 0, Target: 3}, {Source: 4, Target: 5}},
+		},
+		"negative number of vertices": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return PathGraph(g, -1)
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			err := test.generate(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			order, _ := g.Order()
+			size, _ := g.Size()
+
+			if order != test.expectedOrder {
+				t.Errorf("expected order %d, got %d", test.expectedOrder, order)
+			}
+
+			if size != test.expectedSize {
+				t.Errorf("expected size %d, got %d", test.expectedSize, size)
+			}
+
+			for _, edge := range test.expectedEdges {
+				if _, err := g.Edge(edge.Source, edge.Target); err != nil {
+					t.Errorf("expected edge (%v, %v): %s", edge.Source, edge.Target, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateScaleFree(t *testing.T) {
+	tests := map[string]struct {
+		traits     []func(*Traits)
+		n, m       int
+		shouldFail bool
+	}{
+		"undirected graph": {
+			traits: []func(*Traits){},
+			n:      100,
+			m:      2,
+		},
+		"directed graph": {
+			traits: []func(*Traits){Directed()},
+			n:      50,
+			m:      3,
+		},
+		"m equals n": {
+			traits: []func(*Traits){},
+			n:      3,
+			m:      3,
+		},
+		"m exceeds n": {
+			traits:     []func(*Traits){},
+			n:          3,
+			m:          4,
+			shouldFail: true,
+		},
+		"m is zero": {
+			traits:     []func(*Traits){},
+			n:          3,
+			m:          0,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			err := GenerateScaleFree(g, test.n, test.m, rand.New(rand.NewSource(42)))
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			order, _ := g.Order()
+			size, _ := g.Size()
+
+			if order != test.n {
+				t.Errorf("expected order %d, got %d", test.n, order)
+			}
+
+			if expectedSize := (test.n - test.m) * test.m; size != expectedSize {
+				t.Errorf("expected size %d, got %d", expectedSize, size)
+			}
+
+			// The same seed has to produce the same graph.
+			h := New(IntHash, test.traits...)
+			_ = GenerateScaleFree(h, test.n, test.m, rand.New(rand.NewSource(42)))
+
+			edges, _ := g.Edges()
+			for _, edge := range edges {
+				if _, err := h.Edge(edge.Source, edge.Target); err != nil {
+					t.Errorf("expected edge (%v, %v) for the same seed", edge.Source, edge.Target)
+				}
+			}
+		})
+	}
+}
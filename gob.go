@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+// gobFormatVersion is the version of the gob format written by ToGob. It has to
+// be incremented whenever the layout of gobGraph changes in a way that gob can't
+// handle by itself, and FromGob has to be extended so that graphs written with
+// older versions can still be read.
+const gobFormatVersion = 1
+
+// gobGraph is the gob representation of a graph as written by ToGob.
+type gobGraph[K comparable, T any] struct {
+	Version  int
+	Traits   gobTraits
+	Vertices []gobVertex[T]
+	Edges    []gobEdge[K]
+}
+
+type gobTraits struct {
+	IsDirected        bool
+	IsAcyclic         bool
+	IsWeighted        bool
+	IsRooted          bool
+	PreventCycles     bool
+	AllowDuplicateAdd bool
+	AllowSelfLoops    bool
+}
+
+type gobVertex[T any] struct {
+	Value      T
+	Weight     int
+	Attributes map[string]string
+}
+
+type gobEdge[K comparable] struct {
+	Source     K
+	Target     K
+	Weight     int
+	Attributes map[string]string
+	Data       any
+}
+
+// ToGob writes the given graph into an io.Writer using the encoding/gob package.
+// Just like [ToJSON], the encoded graph contains the format version, the traits,
+// and all vertices and edges along with their properties, but gob preserves the
+// exact types of the values. Concrete types stored in the Data field of the
+// edges have to be registered using gob.Register. The graph can be restored
+// using [FromGob].
+func ToGob[K comparable, T any](g Graph[K, T], w io.Writer) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	traits := g.Traits()
+
+	document := gobGraph[K, T]{
+		Version: gobFormatVersion,
+		Traits: gobTraits{
+			IsDirected:        traits.IsDirected,
+			IsAcyclic:         traits.IsAcyclic,
+			IsWeighted:        traits.IsWeighted,
+			IsRooted:          traits.IsRooted,
+			PreventCycles:     traits.PreventCycles,
+			AllowDuplicateAdd: traits.AllowDuplicateAdd,
+			AllowSelfLoops:    traits.AllowSelfLoops,
+		},
+		Vertices: make([]gobVertex[T], 0, len(adjacencyMap)),
+		Edges:    make([]gobEdge[K], 0, len(edges)),
+	}
+
+	for hash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		document.Vertices = append(document.Vertices, gobVertex[T]{
+			Value:      vertex,
+			Weight:     properties.Weight,
+			Attributes: properties.Attributes,
+		})
+	}
+
+	for _, edge := range edges {
+		document.Edges = append(document.Edges, gobEdge[K]{
+			Source:     edge.Source,
+			Target:     edge.Target,
+			Weight:     edge.Properties.Weight,
+			Attributes: edge.Properties.Attributes,
+			Data:       edge.Properties.Data,
+		})
+	}
+
+	if err := gob.NewEncoder(w).Encode(document); err != nil {
+		return fmt.Errorf("failed to encode graph: %w", err)
+	}
+
+	return nil
+}
+
+// FromGob reads a graph written by [ToGob] from an io.Reader and creates a new
+// graph from it, using the given hashing function just as [New] would. If the
+// graph has been written by a newer version that isn't supported yet, an error
+// will be returned.
+func FromGob[K comparable, T any](r io.Reader, hash Hash[K, T]) (Graph[K, T], error) {
+	var document gobGraph[K, T]
+
+	if err := gob.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to decode graph: %w", err)
+	}
+
+	if document.Version < 1 || document.Version > gobFormatVersion {
+		return nil, fmt.Errorf("unsupported format version %d, the latest supported version is %d", document.Version, gobFormatVersion)
+	}
+
+	copyTraits := func(t *Traits) {
+		t.IsDirected = document.Traits.IsDirected
+		t.IsAcyclic = document.Traits.IsAcyclic
+		t.IsWeighted = document.Traits.IsWeighted
+		t.IsRooted = document.Traits.IsRooted
+		t.PreventCycles = document.Traits.PreventCycles
+		t.AllowDuplicateAdd = document.Traits.AllowDuplicateAdd
+		t.AllowSelfLoops = document.Traits.AllowSelfLoops
+	}
+
+	g := New(hash, copyTraits)
+
+	for _, vertex := range document.Vertices {
+		properties := VertexProperties{
+			Weight:     vertex.Weight,
+			Attributes: vertex.Attributes,
+		}
+		if err := g.AddVertex(vertex.Value, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash(vertex.Value), err)
+		}
+	}
+
+	for _, edge := range document.Edges {
+		properties := EdgeProperties{
+			Weight:     edge.Weight,
+			Attributes: edge.Attributes,
+			Data:       edge.Data,
+		}
+		if err := g.AddEdge(copyEdge(Edge[K]{Source: edge.Source, Target: edge.Target, Properties: properties})); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/gob"
+	"strings"
+	"testing"
+)
+
+func TestToGobFromGob(t *testing.T) {
+	tests := map[string]struct {
+		traits           []func(*Traits)
+		vertices         []int
+		vertexProperties map[int]VertexProperties
+		edges            []Edge[int]
+	}{
+		"directed weighted graph with properties": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []int{1, 2, 3},
+			vertexProperties: map[int]VertexProperties{
+				1: {Weight: 3, Attributes: map[string]string{"color": "red"}},
+			},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4, Attributes: map[string]string{"label": "ab"}}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2, Data: int64(42)}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Data: 1.5}},
+			},
+		},
+		"undirected graph with self-loop": {
+			traits:   []func(*Traits){AllowSelfLoops()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 3},
+			},
+		},
+		"acyclic graph preventing cycles": {
+			traits:   []func(*Traits){Directed(), PreventCycles()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+		},
+		"empty graph": {
+			traits: []func(*Traits){Directed()},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex, copyVertexProperties(test.vertexProperties[vertex]))
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			var buf bytes.Buffer
+
+			if err := ToGob(g, &buf); err != nil {
+				t.Fatalf("failed to write gob: %s", err.Error())
+			}
+
+			h, err := FromGob(&buf, IntHash)
+			if err != nil {
+				t.Fatalf("failed to read gob: %s", err.Error())
+			}
+
+			if !traitsAreEqual(g.Traits(), h.Traits()) {
+				t.Errorf("expected traits %v, got %v", g.Traits(), h.Traits())
+			}
+
+			for _, vertex := range test.vertices {
+				_, expectedProperties, _ := g.VertexWithProperties(vertex)
+				_, properties, err := h.VertexWithProperties(vertex)
+				if err != nil {
+					t.Fatalf("expected vertex %v: %s", vertex, err.Error())
+				}
+				if !vertexPropertiesAreEqual(expectedProperties, properties) {
+					t.Errorf("expected properties %v for vertex %v, got %v", expectedProperties, vertex, properties)
+				}
+			}
+
+			expectedAdjacencyMap, _ := g.AdjacencyMap()
+			adjacencyMap, _ := h.AdjacencyMap()
+
+			// Comparing the Data fields also compares their dynamic types, so
+			// int64(42) isn't equal to an int or float64 with the same value.
+			if !adjacencyMapsAreEqual(expectedAdjacencyMap, adjacencyMap, func(a, b Edge[int]) bool {
+				return a.Source == b.Source && a.Target == b.Target && a.Properties.Data == b.Properties.Data
+			}) {
+				t.Errorf("expected adjacency map %v, got %v", expectedAdjacencyMap, adjacencyMap)
+			}
+		})
+	}
+}
+
+func TestFromGob_invalid(t *testing.T) {
+	if _, err := FromGob(bytes.NewBufferString("not gob"), IntHash); err == nil {
+		t.Errorf("expected error for invalid gob")
+	}
+}
+
+func TestFromGob_version(t *testing.T) {
+	tests := map[string]struct {
+		version       int
+		shouldFail    bool
+		errorContains string
+	}{
+		"version 1": {
+			version: 1,
+		},
+		"future version": {
+			version:       2,
+			shouldFail:    true,
+			errorContains: "unsupported format version 2",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			document := gobGraph[int, int]{
+				Version:  test.version,
+				Traits:   gobTraits{IsDirected: true},
+				Vertices: []gobVertex[int]{{Value: 1}, {Value: 2}},
+				Edges:    []gobEdge[int]{{Source: 1, Target: 2}},
+			}
+
+			var buf bytes.Buffer
+
+			if err := gob.NewEncoder(&buf).Encode(document); err != nil {
+				t.Fatalf("failed to encode document: %s", err.Error())
+			}
+
+			g, err := FromGob(&buf, IntHash)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				if !strings.Contains(err.Error(), test.errorContains) {
+					t.Errorf("expected error to contain %q, got %q", test.errorContains, err.Error())
+				}
+				return
+			}
+
+			if size, _ := g.Size(); size != 1 {
+				t.Errorf("expected 1 edge, got %d", size)
+			}
+		})
+	}
+}
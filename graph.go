@@ -49,6 +49,11 @@
 // For detailed usage examples, take a look at the README.
 package graph
 
+import (
+	"errors"
+	"fmt"
+)
+
 // Graph represents a generic graph data structure consisting of vertices of
 // type T identified by a hash of type K.
 type Graph[K comparable, T any] interface {
@@ -57,7 +62,8 @@ type Graph[K comparable, T any] interface {
 	Traits() *Traits
 
 	// AddVertex creates a new vertex in the graph. If the vertex already exists
-	// in the graph, ErrVertexAlreadyExists will be returned.
+	// in the graph, ErrVertexAlreadyExists will be returned, unless duplicates
+	// are allowed using AllowDuplicateAdd.
 	//
 	// AddVertex accepts a variety of functional options to set further edge
 	// details such as the weight or an attribute:
@@ -91,9 +97,12 @@ type Graph[K comparable, T any] interface {
 	// AddEdge creates an edge between the source and the target vertex.
 	//
 	// If either vertex cannot be found, ErrVertexNotFound will be returned. If
-	// the edge already exists, ErrEdgeAlreadyExists will be returned. If cycle
-	// prevention has been activated using PreventCycles and if adding the edge
-	// would create a cycle, ErrEdgeCreatesCycle will be returned.
+	// the edge already exists, ErrEdgeAlreadyExists will be returned, unless
+	// duplicates are allowed using AllowDuplicateAdd. If the source and the
+	// target vertex are the same, ErrSelfLoop will be returned, unless
+	// self-loops are allowed using AllowSelfLoops. If cycle prevention has been
+	// activated using PreventCycles and if adding the edge would create a
+	// cycle, ErrEdgeCreatesCycle will be returned.
 	//
 	// AddEdge accepts functional options to set further edge properties such as
 	// the weight or an attribute:
@@ -108,6 +117,12 @@ type Graph[K comparable, T any] interface {
 	// All vertices that the edges are joining have to exist already. If needed,
 	// these vertices can be added using AddVerticesFrom first. Depending on the
 	// situation, it also might make sense to clone the entire original graph.
+	//
+	// AddEdgesFrom is atomic: All edges are validated before any of them gets
+	// added, and if one of them cannot be added, none of them will be added.
+	// Edges are rejected if a vertex doesn't exist, if the edge already exists
+	// or appears twice, or if it would create a cycle with PreventCycles set.
+	// With AllowDuplicateAdd, existing edges are skipped instead.
 	AddEdgesFrom(g Graph[K, T]) error
 
 	// Edge returns the edge joining two given vertices or ErrEdgeNotFound if
@@ -160,6 +175,10 @@ type Graph[K comparable, T any] interface {
 	//	}
 	//
 	// This design makes AdjacencyMap suitable for a wide variety of algorithms.
+	//
+	// A self-loop (A,A) appears as an entry for A in the adjacencies of A. For
+	// an undirected graph, it is contained only once, so A counts as a single
+	// adjacency of itself when determining the degree of A.
 	AdjacencyMap() (map[K]map[K]Edge[K], error)
 
 	// PredecessorMap computes a predecessor map with all vertices in the graph.
@@ -183,6 +202,8 @@ type Graph[K comparable, T any] interface {
 	// For an undirected graph, PredecessorMap is the same as AdjacencyMap. This
 	// is because there is no distinction between "outgoing" and "ingoing" edges
 	// in an undirected graph.
+	//
+	// A self-loop (A,A) appears as an entry for A in the predecessors of A.
 	PredecessorMap() (map[K]map[K]Edge[K], error)
 
 	// Clone creates a deep copy of the graph and returns that cloned graph.
@@ -276,17 +297,261 @@ func NewLike[K comparable, T any](g Graph[K, T]) Graph[K, T] {
 		t.IsWeighted = g.Traits().IsWeighted
 		t.IsRooted = g.Traits().IsRooted
 		t.PreventCycles = g.Traits().PreventCycles
+		t.AllowDuplicateAdd = g.Traits().AllowDuplicateAdd
+		t.AllowSelfLoops = g.Traits().AllowSelfLoops
+	}
+
+	return New(hashOf(g), copyTraits)
+}
+
+// Snapshot creates a point-in-time copy of the given graph with the same traits,
+// which can be read without blocking writers on the original graph. If the store
+// of the graph implements a Snapshot method, like the default in-memory store
+// does, it is copied under a single read lock, so that the snapshot is consistent
+// even if the graph is being modified concurrently. Otherwise, Snapshot falls
+// back to Clone.
+func Snapshot[K comparable, T any](g Graph[K, T]) (Graph[K, T], error) {
+	var store Store[K, T]
+
+	switch graph := g.(type) {
+	case *directed[K, T]:
+		store = graph.store
+	case *undirected[K, T]:
+		store = graph.store
+	}
+
+	snapshotter, ok := store.(interface {
+		Snapshot() (Store[K, T], error)
+	})
+	if !ok {
+		return g.Clone()
+	}
+
+	snapshot, err := snapshotter.Snapshot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create store snapshot: %w", err)
+	}
+
+	traits := *g.Traits()
+
+	return NewWithStore(hashOf(g), snapshot, func(t *Traits) {
+		*t = traits
+	}), nil
+}
+
+// CopyReport lists the vertices and edges that have been skipped when copying a
+// graph using [CopyToCollecting] because they already existed in the target.
+type CopyReport[K comparable] struct {
+	SkippedVertices []K
+	SkippedEdges    []EdgeKey[K]
+}
+
+// CopyToCollecting copies all vertices and edges along with their properties
+// from the source graph into the target graph. In contrast to AddVerticesFrom
+// and AddEdgesFrom, it skips vertices and edges that already exist in the target
+// graph and reports them in the returned [CopyReport].
+//
+// Other errors, for example an edge that would create a cycle, abort the copy
+// operation. In that case, the vertices and edges copied so far remain in the
+// target graph.
+func CopyToCollecting[K comparable, T any](source, target Graph[K, T]) (CopyReport[K], error) {
+	report := CopyReport[K]{
+		SkippedVertices: make([]K, 0),
+		SkippedEdges:    make([]EdgeKey[K], 0),
+	}
+
+	adjacencyMap, err := source.AdjacencyMap()
+	if err != nil {
+		return report, fmt.Errorf("failed to get adjacency map: %w", err)
 	}
 
-	var hash Hash[K, T]
+	for hash := range adjacencyMap {
+		vertex, properties, err := source.VertexWithProperties(hash)
+		if err != nil {
+			return report, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		err = target.AddVertex(vertex, copyVertexProperties(properties))
+		if errors.Is(err, ErrVertexAlreadyExists) {
+			report.SkippedVertices = append(report.SkippedVertices, hash)
+			continue
+		}
+		if err != nil {
+			return report, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+	}
+
+	edges, err := source.Edges()
+	if err != nil {
+		return report, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	for _, edge := range edges {
+		err = target.AddEdge(copyEdge(edge))
+		if errors.Is(err, ErrEdgeAlreadyExists) {
+			report.SkippedEdges = append(report.SkippedEdges, EdgeKey[K]{Source: edge.Source, Target: edge.Target})
+			continue
+		}
+		if err != nil {
+			return report, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return report, nil
+}
+
+// hashOf returns the hashing function of the given graph. The graph has to be
+// one of the graph implementations provided by this library.
+func hashOf[K comparable, T any](g Graph[K, T]) Hash[K, T] {
+	if observable, ok := g.(*ObservableGraph[K, T]); ok {
+		return observable.hash
+	}
 
 	if g.Traits().IsDirected {
-		hash = g.(*directed[K, T]).hash
-	} else {
-		hash = g.(*undirected[K, T]).hash
+		return g.(*directed[K, T]).hash
+	}
+
+	return g.(*undirected[K, T]).hash
+}
+
+// readAdjacencyMap returns the adjacency map of the given graph for algorithms
+// that only read it. If the graph caches its adjacency map, the cached map is
+// returned, which is shared and must not be modified.
+func readAdjacencyMap[K comparable, T any](g Graph[K, T]) (map[K]map[K]Edge[K], error) {
+	// If the graph is able to provide a cached adjacency map, use that fast path.
+	if c, ok := g.(interface {
+		cachedAdjacencyMap() (map[K]map[K]Edge[K], error)
+	}); ok {
+		return c.cachedAdjacencyMap()
+	}
+
+	return g.AdjacencyMap()
+}
+
+// readPredecessorMap is the equivalent of readAdjacencyMap for the predecessor
+// map. The returned map must not be modified either.
+func readPredecessorMap[K comparable, T any](g Graph[K, T]) (map[K]map[K]Edge[K], error) {
+	// If the graph is able to provide a cached predecessor map, use that fast
+	// path.
+	if c, ok := g.(interface {
+		cachedPredecessorMap() (map[K]map[K]Edge[K], error)
+	}); ok {
+		return c.cachedPredecessorMap()
+	}
+
+	return g.PredecessorMap()
+}
+
+// validateEdges checks whether all of the given edges can be added to g without
+// actually adding them. An edge is invalid if one of its vertices doesn't exist
+// or if it already exists, either in g or earlier in the given edges. If g has
+// the PreventCycles trait, edges that would create a cycle are invalid as well,
+// taking all preceding edges into account.
+//
+// validateEdges returns the edges that need to be added. These are all given
+// edges, except for existing edges that are skipped due to AllowDuplicateAdd.
+func validateEdges[K comparable, T any](g Graph[K, T], edges []Edge[K]) ([]Edge[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	traits := g.Traits()
+
+	// The predecessors of each vertex, including those joined by the edges
+	// that have already been validated. This is only needed for cycle checks.
+	var predecessors map[K]map[K]struct{}
+
+	if traits.PreventCycles {
+		predecessors = make(map[K]map[K]struct{}, len(adjacencyMap))
+		for vertex := range adjacencyMap {
+			predecessors[vertex] = make(map[K]struct{})
+		}
+		for source, adjacencies := range adjacencyMap {
+			for target := range adjacencies {
+				predecessors[target][source] = struct{}{}
+			}
+		}
+	}
+
+	pending := make(map[EdgeKey[K]]struct{}, len(edges))
+	validEdges := make([]Edge[K], 0, len(edges))
+
+	for _, edge := range edges {
+		source, target := edge.Source, edge.Target
+
+		if _, ok := adjacencyMap[source]; !ok {
+			return nil, fmt.Errorf("failed to add (%v, %v): %w", source, target, &VertexNotFoundError[K]{Key: source})
+		}
+
+		if _, ok := adjacencyMap[target]; !ok {
+			return nil, fmt.Errorf("failed to add (%v, %v): %w", source, target, &VertexNotFoundError[K]{Key: target})
+		}
+
+		_, exists := adjacencyMap[source][target]
+		_, isPending := pending[EdgeKey[K]{Source: source, Target: target}]
+		if !traits.IsDirected && !isPending {
+			_, isPending = pending[EdgeKey[K]{Source: target, Target: source}]
+		}
+
+		if (exists || isPending) && traits.AllowDuplicateAdd {
+			continue
+		}
+
+		if exists || isPending {
+			return nil, fmt.Errorf("failed to add (%v, %v): %w", source, target, &EdgeAlreadyExistsError[K]{Source: source, Target: target})
+		}
+
+		if source == target && !traits.AllowSelfLoops {
+			return nil, fmt.Errorf("failed to add (%v, %v): %w", source, target, &SelfLoopError[K]{Key: source})
+		}
+
+		if traits.PreventCycles {
+			if createsCycleIn(predecessors, source, target) {
+				return nil, fmt.Errorf("failed to add (%v, %v): %w", source, target, &EdgeCausesCycleError[K]{Source: source, Target: target})
+			}
+			predecessors[target][source] = struct{}{}
+			if !traits.IsDirected {
+				predecessors[source][target] = struct{}{}
+			}
+		}
+
+		pending[EdgeKey[K]{Source: source, Target: target}] = struct{}{}
+		validEdges = append(validEdges, edge)
+	}
+
+	return validEdges, nil
+}
+
+// createsCycleIn works just like CreatesCycle, but uses the given predecessors
+// instead of the predecessor map of a graph.
+func createsCycleIn[K comparable](predecessors map[K]map[K]struct{}, source, target K) bool {
+	if source == target {
+		return true
+	}
+
+	stack := newStack[K]()
+	visited := make(map[K]struct{})
+
+	stack.push(source)
+
+	for !stack.isEmpty() {
+		currentHash, _ := stack.pop()
+
+		if _, ok := visited[currentHash]; !ok {
+			if currentHash == target {
+				return true
+			}
+
+			visited[currentHash] = struct{}{}
+
+			for adjacency := range predecessors[currentHash] {
+				stack.push(adjacency)
+			}
+		}
 	}
 
-	return New(hash, copyTraits)
+	return false
 }
 
 // StringHash is a hashing function that accepts a string and uses that exact
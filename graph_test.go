@@ -93,6 +93,218 @@ func TestNewLike(t *testing.T) {
 	}
 }
 
+func TestCopyToCollecting(t *testing.T) {
+	tests := map[string]struct {
+		traits                  []func(*Traits)
+		sourceVertices          []int
+		sourceEdges             []Edge[int]
+		targetVertices          []int
+		targetEdges             []Edge[int]
+		expectedSkippedVertices []int
+		expectedSkippedEdges    int
+		expectedOrder           int
+		expectedSize            int
+	}{
+		"directed graphs with overlaps": {
+			traits:         []func(*Traits){Directed()},
+			sourceVertices: []int{1, 2, 3, 4},
+			sourceEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			targetVertices: []int{2, 3, 5},
+			targetEdges: []Edge[int]{
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 5},
+			},
+			expectedSkippedVertices: []int{2, 3},
+			expectedSkippedEdges:    1,
+			expectedOrder:           5,
+			expectedSize:            4,
+		},
+		"undirected edge in opposite direction": {
+			traits:         []func(*Traits){},
+			sourceVertices: []int{1, 2},
+			sourceEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			targetVertices: []int{1, 2},
+			targetEdges: []Edge[int]{
+				{Source: 2, Target: 1},
+			},
+			expectedSkippedVertices: []int{1, 2},
+			expectedSkippedEdges:    1,
+			expectedOrder:           2,
+			expectedSize:            1,
+		},
+		"empty target": {
+			traits:         []func(*Traits){Directed()},
+			sourceVertices: []int{1, 2},
+			sourceEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedSkippedVertices: []int{},
+			expectedSkippedEdges:    0,
+			expectedOrder:           2,
+			expectedSize:            1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			source := New(IntHash, test.traits...)
+			target := New(IntHash, test.traits...)
+
+			for _, vertex := range test.sourceVertices {
+				_ = source.AddVertex(vertex)
+			}
+			for _, edge := range test.sourceEdges {
+				_ = source.AddEdge(edge.Source, edge.Target)
+			}
+
+			for _, vertex := range test.targetVertices {
+				_ = target.AddVertex(vertex)
+			}
+			for _, edge := range test.targetEdges {
+				_ = target.AddEdge(edge.Source, edge.Target)
+			}
+
+			report, err := CopyToCollecting(source, target)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !slicesAreEqual(report.SkippedVertices, test.expectedSkippedVertices) {
+				t.Errorf("expected skipped vertices %v, got %v", test.expectedSkippedVertices, report.SkippedVertices)
+			}
+
+			if len(report.SkippedEdges) != test.expectedSkippedEdges {
+				t.Errorf("expected %d skipped edges, got %v", test.expectedSkippedEdges, report.SkippedEdges)
+			}
+
+			if order, _ := target.Order(); order != test.expectedOrder {
+				t.Errorf("expected order %d, got %d", test.expectedOrder, order)
+			}
+
+			if size, _ := target.Size(); size != test.expectedSize {
+				t.Errorf("expected size %d, got %d", test.expectedSize, size)
+			}
+		})
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	tests := map[string]struct {
+		traits []func(*Traits)
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed(), Weighted()},
+		},
+		"undirected graph": {
+			traits: []func(*Traits){Weighted()},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			_ = g.AddVertex(1, VertexAttribute("color", "red"))
+			_ = g.AddVertex(2)
+			_ = g.AddVertex(3)
+			_ = g.AddEdge(1, 2, EdgeWeight(4), EdgeAttribute("label", "a"))
+			_ = g.AddEdge(2, 3, EdgeWeight(2))
+
+			snapshot, err := Snapshot(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !traitsAreEqual(g.Traits(), snapshot.Traits()) {
+				t.Errorf("expected traits %v, got %v", g.Traits(), snapshot.Traits())
+			}
+
+			// Modifying the original graph must not affect the snapshot.
+			_ = g.AddVertex(4)
+			_ = g.AddEdge(3, 4)
+			_ = g.UpdateEdge(1, 2, EdgeWeight(10))
+			_ = g.RemoveEdge(2, 3)
+
+			if order, _ := snapshot.Order(); order != 3 {
+				t.Errorf("expected snapshot order 3, got %d", order)
+			}
+
+			if size, _ := snapshot.Size(); size != 2 {
+				t.Errorf("expected snapshot size 2, got %d", size)
+			}
+
+			edge, err := snapshot.Edge(1, 2)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if edge.Properties.Weight != 4 {
+				t.Errorf("expected snapshot edge weight 4, got %d", edge.Properties.Weight)
+			}
+
+			// Modifying the snapshot must not affect the original graph.
+			edge.Properties.Attributes["label"] = "b"
+			_, properties, _ := snapshot.VertexWithProperties(1)
+			properties.Attributes["color"] = "blue"
+
+			if edge, _ := g.Edge(1, 2); edge.Properties.Attributes["label"] != "a" {
+				t.Errorf("expected original edge label a, got %v", edge.Properties.Attributes["label"])
+			}
+
+			if _, properties, _ := g.VertexWithProperties(1); properties.Attributes["color"] != "red" {
+				t.Errorf("expected original vertex color red, got %v", properties.Attributes["color"])
+			}
+		})
+	}
+}
+
+func TestSnapshot_concurrentWriter(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 100; i++ {
+		_ = g.AddVertex(i)
+		if i > 0 {
+			_ = g.AddEdge(i-1, i)
+		}
+	}
+
+	snapshot, err := Snapshot(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for i := 100; i < 200; i++ {
+			_ = g.AddVertex(i)
+			_ = g.AddEdge(i-1, i)
+		}
+	}()
+
+	path, err := ShortestPath(snapshot, 0, 99)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	<-done
+
+	if len(path) != 100 {
+		t.Errorf("expected path of length 100, got %d", len(path))
+	}
+
+	if order, _ := snapshot.Order(); order != 100 {
+		t.Errorf("expected snapshot order 100, got %d", order)
+	}
+}
+
 func TestStringHash(t *testing.T) {
 	tests := map[string]struct {
 		value        string
@@ -0,0 +1,198 @@
+package graph
+
+import "fmt"
+
+// AttributeIndex maps attribute key-value pairs to the vertices and edges that
+// have them. It is a snapshot of the graph at the time it has been created and
+// has to be updated using the Index* and Unindex* methods, or automatically by
+// observing an [ObservableGraph] with [IndexObserver]. An AttributeIndex is not
+// safe for concurrent use.
+type AttributeIndex[K comparable] struct {
+	isDirected       bool
+	vertices         map[attribute]map[K]struct{}
+	edges            map[attribute]map[EdgeKey[K]]struct{}
+	vertexAttributes map[K]map[string]string
+	edgeAttributes   map[EdgeKey[K]]map[string]string
+}
+
+// attribute is a single key-value pair of vertex or edge attributes.
+type attribute struct {
+	key, value string
+}
+
+// NewAttributeIndex creates an [AttributeIndex] containing the attributes of all
+// vertices and edges of the given graph. Each edge of an undirected graph is
+// only indexed once, joining the vertices in the direction in which it has been
+// indexed first. It can be updated and unindexed using either direction.
+func NewAttributeIndex[K comparable, T any](g Graph[K, T]) (*AttributeIndex[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	index := &AttributeIndex[K]{
+		isDirected:       g.Traits().IsDirected,
+		vertices:         make(map[attribute]map[K]struct{}),
+		edges:            make(map[attribute]map[EdgeKey[K]]struct{}),
+		vertexAttributes: make(map[K]map[string]string),
+		edgeAttributes:   make(map[EdgeKey[K]]map[string]string),
+	}
+
+	for hash := range adjacencyMap {
+		_, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		index.IndexVertex(hash, properties.Attributes)
+	}
+
+	for _, edge := range edges {
+		index.IndexEdge(edge.Source, edge.Target, edge.Properties.Attributes)
+	}
+
+	return index, nil
+}
+
+// VerticesWith returns the hashes of all vertices that have the given attribute
+// with the given value. The order of the returned vertices is not specified.
+func (a *AttributeIndex[K]) VerticesWith(key, value string) []K {
+	hashes := make([]K, 0, len(a.vertices[attribute{key, value}]))
+
+	for hash := range a.vertices[attribute{key, value}] {
+		hashes = append(hashes, hash)
+	}
+
+	return hashes
+}
+
+// EdgesWith returns the keys of all edges that have the given attribute with the
+// given value. The order of the returned edges is not specified.
+func (a *AttributeIndex[K]) EdgesWith(key, value string) []EdgeKey[K] {
+	edges := make([]EdgeKey[K], 0, len(a.edges[attribute{key, value}]))
+
+	for edge := range a.edges[attribute{key, value}] {
+		edges = append(edges, edge)
+	}
+
+	return edges
+}
+
+// IndexVertex adds the given vertex along with its attributes to the index. If
+// the vertex has already been indexed, its previous attributes are replaced.
+func (a *AttributeIndex[K]) IndexVertex(hash K, attributes map[string]string) {
+	a.UnindexVertex(hash)
+
+	copied := make(map[string]string, len(attributes))
+
+	for key, value := range attributes {
+		attr := attribute{key, value}
+		if _, ok := a.vertices[attr]; !ok {
+			a.vertices[attr] = make(map[K]struct{})
+		}
+		a.vertices[attr][hash] = struct{}{}
+		copied[key] = value
+	}
+
+	a.vertexAttributes[hash] = copied
+}
+
+// UnindexVertex removes the given vertex from the index. Removing a vertex that
+// hasn't been indexed has no effect.
+func (a *AttributeIndex[K]) UnindexVertex(hash K) {
+	for key, value := range a.vertexAttributes[hash] {
+		attr := attribute{key, value}
+		delete(a.vertices[attr], hash)
+		if len(a.vertices[attr]) == 0 {
+			delete(a.vertices, attr)
+		}
+	}
+
+	delete(a.vertexAttributes, hash)
+}
+
+// IndexEdge adds the edge joining the given vertices along with its attributes
+// to the index. If the edge has already been indexed, its previous attributes
+// are replaced.
+func (a *AttributeIndex[K]) IndexEdge(source, target K, attributes map[string]string) {
+	a.UnindexEdge(source, target)
+
+	edge := a.edgeKey(source, target)
+	copied := make(map[string]string, len(attributes))
+
+	for key, value := range attributes {
+		attr := attribute{key, value}
+		if _, ok := a.edges[attr]; !ok {
+			a.edges[attr] = make(map[EdgeKey[K]]struct{})
+		}
+		a.edges[attr][edge] = struct{}{}
+		copied[key] = value
+	}
+
+	a.edgeAttributes[edge] = copied
+}
+
+// UnindexEdge removes the edge joining the given vertices from the index.
+// Removing an edge that hasn't been indexed has no effect.
+func (a *AttributeIndex[K]) UnindexEdge(source, target K) {
+	edge := a.edgeKey(source, target)
+
+	for key, value := range a.edgeAttributes[edge] {
+		attr := attribute{key, value}
+		delete(a.edges[attr], edge)
+		if len(a.edges[attr]) == 0 {
+			delete(a.edges, attr)
+		}
+	}
+
+	delete(a.edgeAttributes, edge)
+}
+
+// edgeKey returns the key under which the edge joining the given vertices is
+// indexed. For undirected graphs, this is the reversed key if the edge has
+// already been indexed in the opposite direction.
+func (a *AttributeIndex[K]) edgeKey(source, target K) EdgeKey[K] {
+	edge := EdgeKey[K]{Source: source, Target: target}
+
+	if !a.isDirected {
+		reversed := EdgeKey[K]{Source: target, Target: source}
+		if _, ok := a.edgeAttributes[reversed]; ok {
+			return reversed
+		}
+	}
+
+	return edge
+}
+
+// IndexObserver returns an [Observer] that keeps the given index up to date with
+// the modifications of the given graph. The graph has to be the [ObservableGraph]
+// that the observer is registered with, since the attributes of added vertices
+// are read from it.
+func IndexObserver[K comparable, T any](g Graph[K, T], index *AttributeIndex[K]) Observer[K, T] {
+	return Observer[K, T]{
+		VertexAdded: func(hash K, _ T) {
+			_, properties, err := g.VertexWithProperties(hash)
+			if err != nil {
+				return
+			}
+			index.IndexVertex(hash, properties.Attributes)
+		},
+		VertexRemoved: func(hash K) {
+			index.UnindexVertex(hash)
+		},
+		EdgeAdded: func(edge Edge[K]) {
+			index.IndexEdge(edge.Source, edge.Target, edge.Properties.Attributes)
+		},
+		EdgeUpdated: func(edge Edge[K]) {
+			index.IndexEdge(edge.Source, edge.Target, edge.Properties.Attributes)
+		},
+		EdgeRemoved: func(edge Edge[K]) {
+			index.UnindexEdge(edge.Source, edge.Target)
+		},
+	}
+}
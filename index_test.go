@@ -0,0 +1,227 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestNewAttributeIndex(t *testing.T) {
+	tests := map[string]struct {
+		traits           []func(*Traits)
+		vertexAttributes map[int]map[string]string
+		edges            []Edge[int]
+		key, value       string
+		expectedVertices []int
+		expectedEdges    []EdgeKey[int]
+	}{
+		"vertices and edges with attribute": {
+			traits: []func(*Traits){Directed()},
+			vertexAttributes: map[int]map[string]string{
+				1: {"color": "red", "shape": "box"},
+				2: {"color": "blue"},
+				3: {"color": "red"},
+				4: {},
+			},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Attributes: map[string]string{"color": "red"}}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Attributes: map[string]string{"color": "green"}}},
+				{Source: 3, Target: 4},
+			},
+			key:              "color",
+			value:            "red",
+			expectedVertices: []int{1, 3},
+			expectedEdges:    []EdgeKey[int]{{Source: 1, Target: 2}},
+		},
+		"undirected edges are indexed once": {
+			traits: []func(*Traits){},
+			vertexAttributes: map[int]map[string]string{
+				1: {},
+				2: {},
+			},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Attributes: map[string]string{"label": "a"}}},
+			},
+			key:              "label",
+			value:            "a",
+			expectedVertices: []int{},
+			expectedEdges:    []EdgeKey[int]{{Source: 1, Target: 2}},
+		},
+		"no matching value": {
+			traits: []func(*Traits){Directed()},
+			vertexAttributes: map[int]map[string]string{
+				1: {"color": "red"},
+			},
+			key:              "color",
+			value:            "yellow",
+			expectedVertices: []int{},
+			expectedEdges:    []EdgeKey[int]{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for vertex, attributes := range test.vertexAttributes {
+				_ = g.AddVertex(vertex, VertexAttributes(attributes))
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			index, err := NewAttributeIndex(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			vertices := index.VerticesWith(test.key, test.value)
+
+			if !slicesAreEqual(vertices, test.expectedVertices) {
+				t.Errorf("expected vertices %v, got %v", test.expectedVertices, vertices)
+			}
+
+			edges := index.EdgesWith(test.key, test.value)
+
+			if len(edges) != len(test.expectedEdges) {
+				t.Fatalf("expected edges %v, got %v", test.expectedEdges, edges)
+			}
+
+			for _, expectedEdge := range test.expectedEdges {
+				found := false
+				for _, edge := range edges {
+					if edge == expectedEdge || (!g.Traits().IsDirected && edge == EdgeKey[int]{Source: expectedEdge.Target, Target: expectedEdge.Source}) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected edge %v, got %v", expectedEdge, edges)
+				}
+			}
+		})
+	}
+}
+
+func TestAttributeIndex_update(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1, VertexAttribute("color", "red"))
+	_ = g.AddVertex(2, VertexAttribute("color", "red"))
+	_ = g.AddEdge(1, 2, EdgeAttribute("color", "red"))
+
+	index, err := NewAttributeIndex(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	index.IndexVertex(2, map[string]string{"color": "blue"})
+	index.IndexVertex(3, map[string]string{"color": "red"})
+	index.UnindexVertex(1)
+	index.UnindexEdge(1, 2)
+
+	if vertices := index.VerticesWith("color", "red"); !slicesAreEqual(vertices, []int{3}) {
+		t.Errorf("expected red vertices %v, got %v", []int{3}, vertices)
+	}
+
+	if vertices := index.VerticesWith("color", "blue"); !slicesAreEqual(vertices, []int{2}) {
+		t.Errorf("expected blue vertices %v, got %v", []int{2}, vertices)
+	}
+
+	if edges := index.EdgesWith("color", "red"); len(edges) != 0 {
+		t.Errorf("expected no red edges, got %v", edges)
+	}
+}
+
+func TestAttributeIndex_undirected(t *testing.T) {
+	g := New(IntHash)
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2, EdgeAttribute("color", "red"))
+
+	index, err := NewAttributeIndex(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	edges := index.EdgesWith("color", "red")
+	if len(edges) != 1 {
+		t.Fatalf("expected 1 red edge, got %v", edges)
+	}
+
+	// Update and remove the edge in the direction opposite to how it has been
+	// indexed.
+	reversed := edges[0]
+	reversed.Source, reversed.Target = reversed.Target, reversed.Source
+
+	index.IndexEdge(reversed.Source, reversed.Target, map[string]string{"color": "blue"})
+
+	if edges := index.EdgesWith("color", "red"); len(edges) != 0 {
+		t.Errorf("expected no red edges, got %v", edges)
+	}
+
+	if edges := index.EdgesWith("color", "blue"); len(edges) != 1 {
+		t.Errorf("expected 1 blue edge, got %v", edges)
+	}
+
+	index.UnindexEdge(reversed.Target, reversed.Source)
+
+	if edges := index.EdgesWith("color", "blue"); len(edges) != 0 {
+		t.Errorf("expected no blue edges, got %v", edges)
+	}
+}
+
+func TestIndexObserver(t *testing.T) {
+	tests := map[string]struct {
+		traits []func(*Traits)
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed()},
+		},
+		"undirected graph": {
+			traits: []func(*Traits){},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewObservable(New(IntHash, test.traits...))
+
+			_ = g.AddVertex(1, VertexAttribute("color", "red"))
+
+			index, err := NewAttributeIndex[int, int](g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			g.Observe(IndexObserver[int, int](g, index))
+
+			_ = g.AddVertex(2, VertexAttribute("color", "red"))
+			_ = g.AddVertex(3, VertexAttribute("color", "green"))
+			_ = g.AddVertex(4, VertexAttribute("color", "red"))
+			_ = g.AddEdge(1, 2, EdgeAttribute("color", "red"))
+			_ = g.AddEdge(2, 3, EdgeAttribute("color", "red"))
+			_ = g.UpdateEdge(2, 3, EdgeAttribute("color", "blue"))
+			_ = g.RemoveVertex(4)
+
+			if vertices := index.VerticesWith("color", "red"); !slicesAreEqual(vertices, []int{1, 2}) {
+				t.Errorf("expected red vertices %v, got %v", []int{1, 2}, vertices)
+			}
+
+			if edges := index.EdgesWith("color", "blue"); len(edges) != 1 {
+				t.Errorf("expected 1 blue edge, got %v", edges)
+			}
+
+			if !g.Traits().IsDirected {
+				_ = g.RemoveEdge(2, 1)
+			} else {
+				_ = g.RemoveEdge(1, 2)
+			}
+
+			if edges := index.EdgesWith("color", "red"); len(edges) != 0 {
+				t.Errorf("expected no red edges, got %v", edges)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// HasMinor determines whether the given minor can be obtained from the
+// undirected graph g by deleting vertices and edges and by contracting edges.
+// The vertex values and hashes of the minor are only used to tell its vertices
+// apart, and it must not contain self-loops. Since the search is exhaustive and
+// takes exponential time, it can be canceled using the given context, in which
+// case the context error will be returned.
+func HasMinor[K comparable, T any](ctx context.Context, g, minor Graph[K, T]) (bool, error) {
+	if g.Traits().IsDirected || minor.Traits().IsDirected {
+		return false, errors.New("minors can only be determined for undirected graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	minorAdjacencyMap, err := minor.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map of minor: %w", err)
+	}
+
+	minorEdges, err := minor.Edges()
+	if err != nil {
+		return false, fmt.Errorf("failed to get edges of minor: %w", err)
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return false, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	for _, edge := range minorEdges {
+		if edge.Source == edge.Target {
+			return false, fmt.Errorf("minor contains a self-loop at %v", edge.Source)
+		}
+	}
+
+	// Deleting and contracting edges never increases the number of vertices or
+	// edges, so a larger graph can't be a minor.
+	if len(minorAdjacencyMap) > len(adjacencyMap) || len(minorEdges) > len(edges) {
+		return false, nil
+	}
+
+	search := newMinorSearch(ctx, adjacencyMap, minorAdjacencyMap, minorEdges)
+
+	return search.run()
+}
+
+// minorSearch searches a minor model by assigning each vertex of the host graph
+// to one of the branch sets representing the minor's vertices, or to none of
+// them. Vertices are identified by their index to keep the search cheap.
+type minorSearch struct {
+	ctx         context.Context
+	adjacencies [][]int
+	minorEdges  [][2]int
+	minorOrder  int
+	branch      []int
+	branchSizes []int
+	steps       int
+}
+
+// noBranch denotes a vertex of the host graph that is not part of any branch
+// set and therefore has been deleted.
+const noBranch = -1
+
+func newMinorSearch[K comparable](ctx context.Context, adjacencyMap, minorAdjacencyMap map[K]map[K]Edge[K], minorEdges []Edge[K]) *minorSearch {
+	indices := make(map[K]int, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		indices[vertex] = len(indices)
+	}
+
+	adjacencies := make([][]int, len(indices))
+	for vertex, vertexAdjacencies := range adjacencyMap {
+		for adjacency := range vertexAdjacencies {
+			adjacencies[indices[vertex]] = append(adjacencies[indices[vertex]], indices[adjacency])
+		}
+	}
+
+	minorIndices := make(map[K]int, len(minorAdjacencyMap))
+	for vertex := range minorAdjacencyMap {
+		minorIndices[vertex] = len(minorIndices)
+	}
+
+	edges := make([][2]int, 0, len(minorEdges))
+	for _, edge := range minorEdges {
+		edges = append(edges, [2]int{minorIndices[edge.Source], minorIndices[edge.Target]})
+	}
+
+	branch := make([]int, len(indices))
+	for i := range branch {
+		branch[i] = noBranch
+	}
+
+	return &minorSearch{
+		ctx:         ctx,
+		adjacencies: adjacencies,
+		minorEdges:  edges,
+		minorOrder:  len(minorIndices),
+		branch:      branch,
+		branchSizes: make([]int, len(minorIndices)),
+	}
+}
+
+func (m *minorSearch) run() (bool, error) {
+	found, err := m.assign(0, m.minorOrder)
+	if err != nil {
+		return false, fmt.Errorf("minor search canceled: %w", err)
+	}
+
+	return found, nil
+}
+
+// assign assigns the vertex with the given index and all subsequent vertices
+// to a branch set. empty is the number of branch sets that are still empty.
+func (m *minorSearch) assign(vertex, empty int) (bool, error) {
+	m.steps++
+	if m.steps%1024 == 0 {
+		if err := m.ctx.Err(); err != nil {
+			return false, err
+		}
+	}
+
+	// Each branch set needs at least one vertex, so the search can be pruned
+	// if there are fewer remaining vertices than empty branch sets.
+	if len(m.branch)-vertex < empty {
+		return false, nil
+	}
+
+	if vertex == len(m.branch) {
+		return m.isMinorModel(), nil
+	}
+
+	for b := noBranch; b < m.minorOrder; b++ {
+		remaining := empty
+
+		if b != noBranch {
+			if m.branchSizes[b] == 0 {
+				remaining--
+			}
+			m.branchSizes[b]++
+		}
+
+		m.branch[vertex] = b
+
+		found, err := m.assign(vertex+1, remaining)
+
+		if b != noBranch {
+			m.branchSizes[b]--
+		}
+		m.branch[vertex] = noBranch
+
+		if found || err != nil {
+			return found, err
+		}
+	}
+
+	return false, nil
+}
+
+// isMinorModel checks whether the current assignment is a valid minor model,
+// i.e. all branch sets are connected and each edge of the minor is represented
+// by an edge joining the corresponding branch sets.
+func (m *minorSearch) isMinorModel() bool {
+	for b := 0; b < m.minorOrder; b++ {
+		if !m.isConnected(b) {
+			return false
+		}
+	}
+
+	joined := make(map[[2]int]struct{})
+
+	for vertex, adjacencies := range m.adjacencies {
+		for _, adjacency := range adjacencies {
+			source, target := m.branch[vertex], m.branch[adjacency]
+			if source == noBranch || target == noBranch || source == target {
+				continue
+			}
+			joined[[2]int{source, target}] = struct{}{}
+		}
+	}
+
+	for _, edge := range m.minorEdges {
+		if _, ok := joined[edge]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isConnected determines whether the vertices assigned to the given branch set
+// form a connected subgraph.
+func (m *minorSearch) isConnected(b int) bool {
+	start := -1
+	for vertex, vertexBranch := range m.branch {
+		if vertexBranch == b {
+			start = vertex
+			break
+		}
+	}
+
+	if start == -1 {
+		return false
+	}
+
+	visited := map[int]struct{}{start: {}}
+	stack := newStack[int]()
+	stack.push(start)
+
+	for !stack.isEmpty() {
+		vertex, _ := stack.pop()
+
+		for _, adjacency := range m.adjacencies[vertex] {
+			if _, ok := visited[adjacency]; ok || m.branch[adjacency] != b {
+				continue
+			}
+			visited[adjacency] = struct{}{}
+			stack.push(adjacency)
+		}
+	}
+
+	return len(visited) == m.branchSizes[b]
+}
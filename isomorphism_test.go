@@ -0,0 +1,185 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestHasMinor(t *testing.T) {
+	tests := map[string]struct {
+		edges         []Edge[int]
+		vertices      []int
+		minorVertices []int
+		minorEdges    []Edge[int]
+		expected      bool
+	}{
+		"subdivided triangle contains triangle": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 1},
+			},
+			minorVertices: []int{1, 2, 3},
+			minorEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expected: true,
+		},
+		"tree doesn't contain triangle": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 3, Target: 5},
+			},
+			minorVertices: []int{1, 2, 3},
+			minorEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expected: false,
+		},
+		"wheel contains K4": {
+			vertices: []int{0, 1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+				{Source: 0, Target: 1},
+				{Source: 0, Target: 2},
+				{Source: 0, Target: 3},
+				{Source: 0, Target: 4},
+			},
+			minorVertices: []int{1, 2, 3, 4},
+			minorEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expected: true,
+		},
+		"cycle doesn't contain K4": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 1},
+			},
+			minorVertices: []int{1, 2, 3, 4},
+			minorEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expected: false,
+		},
+		"disconnected minor": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			minorVertices: []int{1, 2, 3},
+			minorEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expected: true,
+		},
+		"minor with more vertices": {
+			vertices:      []int{1, 2},
+			edges:         []Edge[int]{{Source: 1, Target: 2}},
+			minorVertices: []int{1, 2, 3},
+			minorEdges:    []Edge[int]{},
+			expected:      false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+			minor := New(IntHash)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			for _, vertex := range test.minorVertices {
+				_ = minor.AddVertex(vertex)
+			}
+			for _, edge := range test.minorEdges {
+				if err := minor.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add minor edge: %s", err.Error())
+				}
+			}
+
+			hasMinor, err := HasMinor(context.Background(), g, minor)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if hasMinor != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, hasMinor)
+			}
+		})
+	}
+}
+
+func TestHasMinor_canceled(t *testing.T) {
+	g := New(IntHash)
+	minor := New(IntHash)
+
+	// A path doesn't contain a triangle, so the search has to be exhaustive
+	// and will definitely check the context.
+	for i := 0; i < 8; i++ {
+		_ = g.AddVertex(i)
+		if i > 0 {
+			_ = g.AddEdge(i-1, i)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		_ = minor.AddVertex(i)
+	}
+	_ = minor.AddEdge(0, 1)
+	_ = minor.AddEdge(1, 2)
+	_ = minor.AddEdge(2, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := HasMinor(ctx, g, minor); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected error %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestHasMinor_directed(t *testing.T) {
+	g := New(IntHash, Directed())
+	minor := New(IntHash, Directed())
+
+	if _, err := HasMinor(context.Background(), g, minor); err == nil {
+		t.Errorf("expected error for directed graphs")
+	}
+}
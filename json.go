@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// jsonFormatVersion is the version of the JSON format written by ToJSON. It has
+// to be incremented whenever the layout of jsonGraph changes, and migrateJSON
+// has to be extended so that documents of older versions can still be read.
+const jsonFormatVersion = 1
+
+// jsonGraph is the JSON representation of a graph as written by ToJSON.
+type jsonGraph[K comparable, T any] struct {
+	Version  int             `json:"version"`
+	Traits   jsonTraits      `json:"traits"`
+	Vertices []jsonVertex[T] `json:"vertices"`
+	Edges    []jsonEdge[K]   `json:"edges"`
+}
+
+type jsonTraits struct {
+	IsDirected        bool `json:"isDirected"`
+	IsAcyclic         bool `json:"isAcyclic"`
+	IsWeighted        bool `json:"isWeighted"`
+	IsRooted          bool `json:"isRooted"`
+	PreventCycles     bool `json:"preventCycles"`
+	AllowDuplicateAdd bool `json:"allowDuplicateAdd,omitempty"`
+	AllowSelfLoops    bool `json:"allowSelfLoops,omitempty"`
+}
+
+type jsonVertex[T any] struct {
+	Value      T                 `json:"value"`
+	Weight     int               `json:"weight"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+type jsonEdge[K comparable] struct {
+	Source     K                 `json:"source"`
+	Target     K                 `json:"target"`
+	Weight     int               `json:"weight"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Data       any               `json:"data,omitempty"`
+}
+
+// ToJSON writes the given graph as JSON into an io.Writer, for example a file.
+// The JSON document contains the format version, the graph's traits, all
+// vertex values along with their properties, and all edges along with their
+// properties. Edges only contain the hash values of the vertices they're
+// joining.
+//
+// Both the vertex type T and the hash type K need to be serializable using the
+// encoding/json package. The same applies to the Data field of each edge.
+//
+//	file, _ := os.Create("./my-graph.json")
+//	_ = graph.ToJSON(g, file)
+//
+// The written graph can be restored using [FromJSON].
+func ToJSON[K comparable, T any](g Graph[K, T], w io.Writer) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	traits := g.Traits()
+
+	document := jsonGraph[K, T]{
+		Version: jsonFormatVersion,
+		Traits: jsonTraits{
+			IsDirected:        traits.IsDirected,
+			IsAcyclic:         traits.IsAcyclic,
+			IsWeighted:        traits.IsWeighted,
+			IsRooted:          traits.IsRooted,
+			PreventCycles:     traits.PreventCycles,
+			AllowDuplicateAdd: traits.AllowDuplicateAdd,
+			AllowSelfLoops:    traits.AllowSelfLoops,
+		},
+		Vertices: make([]jsonVertex[T], 0, len(adjacencyMap)),
+		Edges:    make([]jsonEdge[K], 0, len(edges)),
+	}
+
+	for hash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		document.Vertices = append(document.Vertices, jsonVertex[T]{
+			Value:      vertex,
+			Weight:     properties.Weight,
+			Attributes: properties.Attributes,
+		})
+	}
+
+	for _, edge := range edges {
+		document.Edges = append(document.Edges, jsonEdge[K]{
+			Source:     edge.Source,
+			Target:     edge.Target,
+			Weight:     edge.Properties.Weight,
+			Attributes: edge.Properties.Attributes,
+			Data:       edge.Properties.Data,
+		})
+	}
+
+	if err := json.NewEncoder(w).Encode(document); err != nil {
+		return fmt.Errorf("failed to encode graph: %w", err)
+	}
+
+	return nil
+}
+
+// FromJSON reads a graph written by [ToJSON] from an io.Reader and creates a
+// new graph from it, using the given hashing function just as [New] would.
+//
+//	file, _ := os.Open("./my-graph.json")
+//	g, _ := graph.FromJSON(file, graph.IntHash)
+//
+// Documents written by older versions of ToJSON are migrated to the current
+// format, and documents written by newer versions result in an error. The Data
+// field of the edges is decoded into the corresponding generic JSON type.
+func FromJSON[K comparable, T any](r io.Reader, hash Hash[K, T]) (Graph[K, T], error) {
+	var document jsonGraph[K, T]
+
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to decode graph: %w", err)
+	}
+
+	if err := migrateJSON(&document); err != nil {
+		return nil, fmt.Errorf("failed to migrate graph: %w", err)
+	}
+
+	copyTraits := func(t *Traits) {
+		t.IsDirected = document.Traits.IsDirected
+		t.IsAcyclic = document.Traits.IsAcyclic
+		t.IsWeighted = document.Traits.IsWeighted
+		t.IsRooted = document.Traits.IsRooted
+		t.PreventCycles = document.Traits.PreventCycles
+		t.AllowDuplicateAdd = document.Traits.AllowDuplicateAdd
+		t.AllowSelfLoops = document.Traits.AllowSelfLoops
+	}
+
+	g := New(hash, copyTraits)
+
+	for _, vertex := range document.Vertices {
+		properties := VertexProperties{
+			Weight:     vertex.Weight,
+			Attributes: vertex.Attributes,
+		}
+		if err := g.AddVertex(vertex.Value, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash(vertex.Value), err)
+		}
+	}
+
+	for _, edge := range document.Edges {
+		properties := EdgeProperties{
+			Weight:     edge.Weight,
+			Attributes: edge.Attributes,
+			Data:       edge.Data,
+		}
+		if err := g.AddEdge(copyEdge(Edge[K]{Source: edge.Source, Target: edge.Target, Properties: properties})); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return g, nil
+}
+
+// migrateJSON upgrades a decoded document to the current jsonFormatVersion, one
+// version at a time. Documents that have been written before the version field
+// was introduced have a version of 0 and share the layout of version 1.
+func migrateJSON[K comparable, T any](document *jsonGraph[K, T]) error {
+	if document.Version < 0 || document.Version > jsonFormatVersion {
+		return fmt.Errorf("unsupported format version %d, the latest supported version is %d", document.Version, jsonFormatVersion)
+	}
+
+	for document.Version < jsonFormatVersion {
+		switch document.Version {
+		case 0:
+			document.Version = 1
+		default:
+			return fmt.Errorf("no migration from version %d", document.Version)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestToJSONFromJSON(t *testing.T) {
+	tests := map[string]struct {
+		traits           []func(*Traits)
+		vertices         []string
+		vertexProperties map[string]VertexProperties
+		edges            []Edge[string]
+	}{
+		"directed weighted graph with properties": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C"},
+			vertexProperties: map[string]VertexProperties{
+				"A": {Weight: 3, Attributes: map[string]string{"color": "red"}},
+			},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4, Attributes: map[string]string{"label": "ab"}}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2, Data: "payload"}},
+			},
+		},
+		"undirected graph": {
+			traits:   []func(*Traits){},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "C"},
+			},
+		},
+		"acyclic graph preventing cycles": {
+			traits:   []func(*Traits){Directed(), PreventCycles()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex, copyVertexProperties(test.vertexProperties[vertex]))
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			var buf bytes.Buffer
+
+			if err := ToJSON(g, &buf); err != nil {
+				t.Fatalf("failed to write JSON: %s", err.Error())
+			}
+
+			h, err := FromJSON(&buf, StringHash)
+			if err != nil {
+				t.Fatalf("failed to read JSON: %s", err.Error())
+			}
+
+			if !traitsAreEqual(g.Traits(), h.Traits()) {
+				t.Errorf("expected traits %v, got %v", g.Traits(), h.Traits())
+			}
+
+			for _, vertex := range test.vertices {
+				_, expectedProperties, _ := g.VertexWithProperties(vertex)
+				_, properties, err := h.VertexWithProperties(vertex)
+				if err != nil {
+					t.Fatalf("expected vertex %v: %s", vertex, err.Error())
+				}
+				if !vertexPropertiesAreEqual(expectedProperties, properties) {
+					t.Errorf("expected properties %v for vertex %v, got %v", expectedProperties, vertex, properties)
+				}
+			}
+
+			expectedAdjacencyMap, _ := g.AdjacencyMap()
+			adjacencyMap, _ := h.AdjacencyMap()
+
+			if !adjacencyMapsAreEqual(expectedAdjacencyMap, adjacencyMap, func(a, b Edge[string]) bool {
+				return a.Source == b.Source && a.Target == b.Target && a.Properties.Data == b.Properties.Data
+			}) {
+				t.Errorf("expected adjacency map %v, got %v", expectedAdjacencyMap, adjacencyMap)
+			}
+		})
+	}
+}
+
+func TestFromJSON_invalid(t *testing.T) {
+	if _, err := FromJSON(bytes.NewBufferString("{"), StringHash); err == nil {
+		t.Errorf("expected error for invalid JSON")
+	}
+}
+
+func TestFromJSON_version(t *testing.T) {
+	tests := map[string]struct {
+		document      string
+		expectedEdges int
+		shouldFail    bool
+		errorContains string
+	}{
+		"version 1": {
+			document:      `{"version":1,"traits":{"isDirected":true},"vertices":[{"value":"A"},{"value":"B"}],"edges":[{"source":"A","target":"B"}]}`,
+			expectedEdges: 1,
+		},
+		"unversioned document": {
+			document:      `{"traits":{"isDirected":true},"vertices":[{"value":"A"},{"value":"B"}],"edges":[{"source":"A","target":"B"}]}`,
+			expectedEdges: 1,
+		},
+		"future version": {
+			document:      `{"version":2,"traits":{"isDirected":true},"vertices":[{"value":"A"}],"edges":[]}`,
+			shouldFail:    true,
+			errorContains: "unsupported format version 2",
+		},
+		"negative version": {
+			document:      `{"version":-1,"traits":{},"vertices":[],"edges":[]}`,
+			shouldFail:    true,
+			errorContains: "unsupported format version -1",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := FromJSON(bytes.NewBufferString(test.document), StringHash)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				if !strings.Contains(err.Error(), test.errorContains) {
+					t.Errorf("expected error to contain %q, got %q", test.errorContains, err.Error())
+				}
+				return
+			}
+
+			size, _ := g.Size()
+			if size != test.expectedEdges {
+				t.Errorf("expected %d edges, got %d", test.expectedEdges, size)
+			}
+		})
+	}
+}
+
+func TestToJSON_version(t *testing.T) {
+	g := New(StringHash)
+	_ = g.AddVertex("A")
+
+	var buf bytes.Buffer
+
+	if err := ToJSON(g, &buf); err != nil {
+		t.Fatalf("failed to write JSON: %s", err.Error())
+	}
+
+	if !strings.Contains(buf.String(), `"version":1`) {
+		t.Errorf("expected document to contain version 1, got %s", buf.String())
+	}
+}
@@ -0,0 +1,177 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// AdjacencyMatrix computes the dense adjacency matrix of the given graph, where
+// matrix[i][j] represents the edge from vertex keys[i] to vertex keys[j]. For
+// weighted graphs, each entry holds the weight of the edge, and for unweighted
+// graphs, an edge is represented by 1. An entry of 0 means that there is no edge.
+// The order of the vertex hashes is not guaranteed to be stable.
+func AdjacencyMatrix[K comparable, T any](g Graph[K, T]) ([][]float64, []K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	keys := make([]K, 0, len(adjacencyMap))
+	indices := make(map[K]int, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		indices[hash] = len(keys)
+		keys = append(keys, hash)
+	}
+
+	matrix := make([][]float64, len(keys))
+
+	for i, source := range keys {
+		matrix[i] = make([]float64, len(keys))
+
+		for target, edge := range adjacencyMap[source] {
+			weight := 1.0
+			if g.Traits().IsWeighted {
+				weight = float64(edge.Properties.Weight)
+			}
+			matrix[i][indices[target]] = weight
+		}
+	}
+
+	return matrix, keys, nil
+}
+
+// IncidenceMatrix computes the dense incidence matrix of the given graph, where
+// matrix[i][j] represents the incidence of vertex keys[i] and edge edges[j]. For
+// a directed graph, the source of an edge is represented by -1 and the target by
+// 1. For an undirected graph, both ends are represented by 1, and each edge is
+// contained only once. The edge weights are not taken into account. The order of
+// the vertex hashes and edges is not guaranteed to be stable.
+func IncidenceMatrix[K comparable, T any](g Graph[K, T]) ([][]float64, []K, []EdgeKey[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	keys := make([]K, 0, len(adjacencyMap))
+	indices := make(map[K]int, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		indices[hash] = len(keys)
+		keys = append(keys, hash)
+	}
+
+	sourceIncidence := 1.0
+	if g.Traits().IsDirected {
+		sourceIncidence = -1
+	}
+
+	matrix := make([][]float64, len(keys))
+	for i := range matrix {
+		matrix[i] = make([]float64, len(edges))
+	}
+
+	edgeKeys := make([]EdgeKey[K], len(edges))
+
+	for j, edge := range edges {
+		edgeKeys[j] = EdgeKey[K]{Source: edge.Source, Target: edge.Target}
+
+		matrix[indices[edge.Source]][j] += sourceIncidence
+		matrix[indices[edge.Target]][j] += 1
+	}
+
+	return matrix, keys, edgeKeys, nil
+}
+
+// LaplacianMatrix computes the dense Laplacian matrix L = D - A of the given
+// graph, where D is the degree matrix and A is the matrix computed by
+// [AdjacencyMatrix], so edge weights are used for weighted graphs. For directed
+// graphs, the out-degree is used. Self-loops are not taken into account. The
+// order of the vertex hashes is not guaranteed to be stable.
+func LaplacianMatrix[K comparable, T any](g Graph[K, T]) ([][]float64, []K, error) {
+	matrix, keys, err := AdjacencyMatrix(g)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to compute adjacency matrix: %w", err)
+	}
+
+	for i := range matrix {
+		degree := 0.0
+
+		for j := range matrix[i] {
+			if i == j {
+				continue
+			}
+			degree += matrix[i][j]
+			matrix[i][j] = -matrix[i][j]
+		}
+
+		matrix[i][i] = degree
+	}
+
+	return matrix, keys, nil
+}
+
+// FromAdjacencyMatrix creates a new graph from the given adjacency matrix, where
+// matrix[i][j] represents the edge from vertices[i] to vertices[j]. The vertices
+// are identified using the given hashing function, just as in [New]. Each
+// non-zero entry results in an edge. If the graph is weighted, the entry is used
+// as edge weight and has to be an integer value. If the graph is undirected, the
+// matrix has to be symmetric.
+func FromAdjacencyMatrix[K comparable, T any](matrix [][]float64, vertices []T, hash Hash[K, T], options ...func(*Traits)) (Graph[K, T], error) {
+	if len(matrix) != len(vertices) {
+		return nil, fmt.Errorf("matrix has %d rows, but there are %d vertices", len(matrix), len(vertices))
+	}
+
+	g := New(hash, options...)
+	keys := make([]K, len(vertices))
+
+	for i, vertex := range vertices {
+		if len(matrix[i]) != len(vertices) {
+			return nil, fmt.Errorf("matrix row %d has %d columns, expected %d", i, len(matrix[i]), len(vertices))
+		}
+
+		if err := g.AddVertex(vertex); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash(vertex), err)
+		}
+
+		keys[i] = hash(vertex)
+	}
+
+	for i := range matrix {
+		for j, weight := range matrix[i] {
+			if !g.Traits().IsDirected {
+				if matrix[j][i] != weight {
+					return nil, fmt.Errorf("matrix of undirected graph is not symmetric at (%d, %d)", i, j)
+				}
+				// The edge (j,i) has already been added as (i,j).
+				if j < i {
+					continue
+				}
+			}
+
+			if weight == 0 {
+				continue
+			}
+
+			var options []func(*EdgeProperties)
+
+			if g.Traits().IsWeighted {
+				if weight != math.Trunc(weight) {
+					return nil, fmt.Errorf("weight %v at (%d, %d) is not an integer", weight, i, j)
+				}
+				options = append(options, EdgeWeight(int(weight)))
+			}
+
+			if err := g.AddEdge(keys[i], keys[j], options...); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", keys[i], keys[j], err)
+			}
+		}
+	}
+
+	return g, nil
+}
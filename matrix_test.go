@@ -0,0 +1,338 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestAdjacencyMatrix(t *testing.T) {
+	tests := map[string]struct {
+		traits   []func(*Traits)
+		vertices []string
+		edges    []Edge[string]
+		expected map[string]map[string]float64
+	}{
+		"directed weighted graph": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2}},
+			},
+			expected: map[string]map[string]float64{
+				"A": {"B": 4},
+				"B": {"C": 2},
+			},
+		},
+		"undirected unweighted graph": {
+			traits:   []func(*Traits){},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4}},
+			},
+			expected: map[string]map[string]float64{
+				"A": {"B": 1},
+				"B": {"A": 1},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			matrix, keys, err := AdjacencyMatrix(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(matrix) != len(test.vertices) || len(keys) != len(test.vertices) {
+				t.Fatalf("expected %d rows and keys, got %d and %d", len(test.vertices), len(matrix), len(keys))
+			}
+
+			for i, source := range keys {
+				for j, target := range keys {
+					if matrix[i][j] != test.expected[source][target] {
+						t.Errorf("expected %v for (%v, %v), got %v", test.expected[source][target], source, target, matrix[i][j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestIncidenceMatrix(t *testing.T) {
+	tests := map[string]struct {
+		traits            []func(*Traits)
+		vertices          []string
+		edges             []Edge[string]
+		expectedColumnSum float64
+	}{
+		"directed triangle": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+				{Source: "C", Target: "A"},
+			},
+			expectedColumnSum: 0,
+		},
+		"undirected triangle": {
+			traits:   []func(*Traits){},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+				{Source: "C", Target: "A"},
+			},
+			expectedColumnSum: 2,
+		},
+		"undirected graph with self-loop": {
+			traits:   []func(*Traits){AllowSelfLoops()},
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "A"},
+				{Source: "A", Target: "B"},
+			},
+			expectedColumnSum: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			matrix, keys, edges, err := IncidenceMatrix(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(matrix) != len(test.vertices) || len(keys) != len(test.vertices) {
+				t.Fatalf("expected %d rows and keys, got %d and %d", len(test.vertices), len(matrix), len(keys))
+			}
+
+			if len(edges) != len(test.edges) {
+				t.Fatalf("expected %d edges, got %d", len(test.edges), len(edges))
+			}
+
+			for j, edge := range edges {
+				sum := 0.0
+				for i, vertex := range keys {
+					if len(matrix[i]) != len(test.edges) {
+						t.Fatalf("expected %d columns, got %d", len(test.edges), len(matrix[i]))
+					}
+					if matrix[i][j] != 0 && vertex != edge.Source && vertex != edge.Target {
+						t.Errorf("expected vertex %v not to be incident to edge %v, got %v", vertex, edge, matrix[i][j])
+					}
+					sum += matrix[i][j]
+				}
+				if sum != test.expectedColumnSum {
+					t.Errorf("expected column sum %v for edge %v, got %v", test.expectedColumnSum, edge, sum)
+				}
+			}
+		})
+	}
+}
+
+func TestLaplacianMatrix(t *testing.T) {
+	tests := map[string]struct {
+		traits   []func(*Traits)
+		vertices []string
+		edges    []Edge[string]
+		expected map[string]map[string]float64
+	}{
+		"undirected path": {
+			traits:   []func(*Traits){},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+			},
+			expected: map[string]map[string]float64{
+				"A": {"A": 1, "B": -1, "C": 0},
+				"B": {"A": -1, "B": 2, "C": -1},
+				"C": {"A": 0, "B": -1, "C": 1},
+			},
+		},
+		"weighted undirected graph with self-loop": {
+			traits:   []func(*Traits){Weighted(), AllowSelfLoops()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "A", Properties: EdgeProperties{Weight: 5}},
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+			},
+			expected: map[string]map[string]float64{
+				"A": {"A": 5, "B": -2, "C": -3},
+				"B": {"A": -2, "B": 2, "C": 0},
+				"C": {"A": -3, "B": 0, "C": 3},
+			},
+		},
+		"directed graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "C"},
+				{Source: "C", Target: "B"},
+			},
+			expected: map[string]map[string]float64{
+				"A": {"A": 2, "B": -1, "C": -1},
+				"B": {"A": 0, "B": 0, "C": 0},
+				"C": {"A": 0, "B": -1, "C": 1},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			matrix, keys, err := LaplacianMatrix(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(matrix) != len(test.vertices) || len(keys) != len(test.vertices) {
+				t.Fatalf("expected %d rows and keys, got %d and %d", len(test.vertices), len(matrix), len(keys))
+			}
+
+			for i, source := range keys {
+				for j, target := range keys {
+					if expected := test.expected[source][target]; matrix[i][j] != expected {
+						t.Errorf("expected entry (%v, %v) to be %v, got %v", source, target, expected, matrix[i][j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFromAdjacencyMatrix(t *testing.T) {
+	tests := map[string]struct {
+		traits               []func(*Traits)
+		matrix               [][]float64
+		vertices             []string
+		expectedAdjacencyMap map[string]map[string]Edge[string]
+		shouldFail           bool
+	}{
+		"directed weighted graph": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			matrix: [][]float64{
+				{0, 4, 0},
+				{0, 0, 2},
+				{0, 0, 0},
+			},
+			vertices: []string{"A", "B", "C"},
+			expectedAdjacencyMap: map[string]map[string]Edge[string]{
+				"A": {"B": {Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4}}},
+				"B": {"C": {Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2}}},
+				"C": {},
+			},
+		},
+		"undirected graph": {
+			traits: []func(*Traits){},
+			matrix: [][]float64{
+				{0, 1},
+				{1, 0},
+			},
+			vertices: []string{"A", "B"},
+			expectedAdjacencyMap: map[string]map[string]Edge[string]{
+				"A": {"B": {Source: "A", Target: "B"}},
+				"B": {"A": {Source: "B", Target: "A"}},
+			},
+		},
+		"asymmetric matrix for undirected graph": {
+			traits: []func(*Traits){},
+			matrix: [][]float64{
+				{0, 1},
+				{0, 0},
+			},
+			vertices:   []string{"A", "B"},
+			shouldFail: true,
+		},
+		"non-integer weight": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			matrix: [][]float64{
+				{0, 1.5},
+				{0, 0},
+			},
+			vertices:   []string{"A", "B"},
+			shouldFail: true,
+		},
+		"mismatching dimensions": {
+			traits: []func(*Traits){Directed()},
+			matrix: [][]float64{
+				{0, 1},
+			},
+			vertices:   []string{"A", "B"},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := FromAdjacencyMatrix(test.matrix, test.vertices, StringHash, test.traits...)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			adjacencyMap, _ := g.AdjacencyMap()
+
+			if !adjacencyMapsAreEqual(test.expectedAdjacencyMap, adjacencyMap, func(a, b Edge[string]) bool {
+				return a.Source == b.Source && a.Target == b.Target
+			}) {
+				t.Errorf("expected adjacency map %v, got %v", test.expectedAdjacencyMap, adjacencyMap)
+			}
+
+			matrix, keys, _ := AdjacencyMatrix(g)
+			roundTrip, err := FromAdjacencyMatrix(matrix, keys, StringHash, test.traits...)
+			if err != nil {
+				t.Fatalf("failed round trip: %s", err.Error())
+			}
+
+			roundTripAdjacencyMap, _ := roundTrip.AdjacencyMap()
+
+			if !adjacencyMapsAreEqual(adjacencyMap, roundTripAdjacencyMap, func(a, b Edge[string]) bool {
+				return a.Source == b.Source && a.Target == b.Target
+			}) {
+				t.Errorf("expected round trip adjacency map %v, got %v", adjacencyMap, roundTripAdjacencyMap)
+			}
+		})
+	}
+}
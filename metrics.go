@@ -0,0 +1,519 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// AggregateEdgeWeights computes summary statistics of the edge weights of the
+// given graph: the sum, the minimum, the maximum, and the mean of all weights.
+// Each edge of an undirected graph is only taken into account once.
+//
+// If the graph has no edges, all statistics are 0.
+func AggregateEdgeWeights[K comparable, T any](g Graph[K, T]) (sum, min, max, mean float64, err error) {
+	edges, err := g.Edges()
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	weights := make([]int, 0, len(edges))
+
+	for _, edge := range edges {
+		weights = append(weights, edge.Properties.Weight)
+	}
+
+	sum, min, max, mean = aggregateWeights(weights)
+
+	return sum, min, max, mean, nil
+}
+
+// AggregateVertexWeights computes summary statistics of the vertex weights of
+// the given graph: the sum, the minimum, the maximum, and the mean of all
+// weights.
+//
+// If the graph has no vertices, all statistics are 0.
+func AggregateVertexWeights[K comparable, T any](g Graph[K, T]) (sum, min, max, mean float64, err error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	weights := make([]int, 0, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		_, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return 0, 0, 0, 0, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+		weights = append(weights, properties.Weight)
+	}
+
+	sum, min, max, mean = aggregateWeights(weights)
+
+	return sum, min, max, mean, nil
+}
+
+// CyclomaticNumber computes the cyclomatic number |E|-|V|+C of the given graph,
+// where C is the number of connected components. It equals the number of cycles
+// returned by [FundamentalCycles]. The graph is treated as undirected, so two
+// edges (A,B) and (B,A) count as two edges.
+func CyclomaticNumber[K comparable, T any](g Graph[K, T]) (int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	components := len(vertices)
+	subtrees := newUnionFind(vertices...)
+
+	for _, edge := range edges {
+		if subtrees.find(edge.Source) != subtrees.find(edge.Target) {
+			subtrees.union(edge.Source, edge.Target)
+			components--
+		}
+	}
+
+	return len(edges) - len(vertices) + components, nil
+}
+
+// IsComplete determines whether the given graph is complete, i.e. whether each
+// pair of distinct vertices is joined by an edge. In a directed graph, each
+// pair has to be joined in both directions. Self-loops are ignored. A graph
+// with less than two vertices is complete.
+//
+// IsComplete first compares the number of edges with the number of edges of a
+// complete graph, which rules out most incomplete graphs quickly, and then
+// verifies the adjacencies of each vertex.
+func IsComplete[K comparable, T any](g Graph[K, T]) (bool, error) {
+	order, err := g.Order()
+	if err != nil {
+		return false, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	size, err := g.Size()
+	if err != nil {
+		return false, fmt.Errorf("failed to get size: %w", err)
+	}
+
+	expectedSize := order * (order - 1)
+	if !g.Traits().IsDirected {
+		expectedSize /= 2
+	}
+
+	// The graph might have more edges than expected due to self-loops, but it
+	// can't be complete with fewer edges.
+	if size < expectedSize {
+		return false, nil
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for vertex, adjacencies := range adjacencyMap {
+		for other := range adjacencyMap {
+			if other == vertex {
+				continue
+			}
+			if _, ok := adjacencies[other]; !ok {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// IsRegular determines whether all vertices of the given graph have the same
+// degree, and returns that degree. In a directed graph, all vertices need to
+// have the same in-degree and the same out-degree, and both need to be equal.
+// A self-loop counts as a single adjacency, just as in the adjacency map.
+//
+// A graph without vertices is regular with a degree of 0. If the graph isn't
+// regular, the returned degree is 0.
+func IsRegular[K comparable, T any](g Graph[K, T]) (bool, int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	degreeMaps := []map[K]map[K]Edge[K]{adjacencyMap}
+
+	if g.Traits().IsDirected {
+		predecessorMap, err := g.PredecessorMap()
+		if err != nil {
+			return false, 0, fmt.Errorf("failed to get predecessor map: %w", err)
+		}
+		degreeMaps = append(degreeMaps, predecessorMap)
+	}
+
+	degree := -1
+
+	for _, degreeMap := range degreeMaps {
+		for _, adjacencies := range degreeMap {
+			if degree == -1 {
+				degree = len(adjacencies)
+			}
+			if len(adjacencies) != degree {
+				return false, 0, nil
+			}
+		}
+	}
+
+	if degree == -1 {
+		return true, 0, nil
+	}
+
+	return true, degree, nil
+}
+
+// DegreeSequence returns the degrees of all vertices in the given graph, sorted
+// in descending order. In a directed graph, the degree of a vertex is the sum
+// of its in-degree and out-degree, so a self-loop adds 2 to its degree. In an
+// undirected graph, a self-loop counts as a single adjacency, just as in the
+// adjacency map.
+func DegreeSequence[K comparable, T any](g Graph[K, T]) ([]int, error) {
+	degrees, err := vertexDegrees(g)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence := make([]int, 0, len(degrees))
+	for _, degree := range degrees {
+		sequence = append(sequence, degree)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(sequence)))
+
+	return sequence, nil
+}
+
+// DegreeHistogram counts the vertices of the given graph by their degree. The
+// returned map contains the number of vertices for each degree that occurs in
+// the graph. The degrees are determined just as for [DegreeSequence].
+func DegreeHistogram[K comparable, T any](g Graph[K, T]) (map[int]int, error) {
+	degrees, err := vertexDegrees(g)
+	if err != nil {
+		return nil, err
+	}
+
+	histogram := make(map[int]int)
+	for _, degree := range degrees {
+		histogram[degree]++
+	}
+
+	return histogram, nil
+}
+
+// vertexDegrees returns the degree of each vertex in the given graph.
+func vertexDegrees[K comparable, T any](g Graph[K, T]) (map[K]int, error) {
+	// If the graph is able to compute the degrees itself, use that fast path.
+	if d, ok := g.(interface {
+		degrees() (map[K]int, error)
+	}); ok {
+		return d.degrees()
+	}
+
+	return adjacencyMapDegrees(g)
+}
+
+// adjacencyMapDegrees is the slow path of vertexDegrees that works with any
+// graph implementation by obtaining its adjacency and predecessor maps.
+func adjacencyMapDegrees[K comparable, T any](g Graph[K, T]) (map[K]int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	degrees := make(map[K]int, len(adjacencyMap))
+	for vertex, adjacencies := range adjacencyMap {
+		degrees[vertex] = len(adjacencies)
+	}
+
+	if !g.Traits().IsDirected {
+		return degrees, nil
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	for vertex, predecessors := range predecessorMap {
+		degrees[vertex] += len(predecessors)
+	}
+
+	return degrees, nil
+}
+
+// IsSparse determines whether the given graph is sparse, meaning that it has at
+// most as many edges as vertices and isn't dense as per [IsDense]. A graph may be
+// neither sparse nor dense.
+func IsSparse[K comparable, T any](g Graph[K, T]) (bool, error) {
+	order, size, maxSize, err := densityOf(g)
+	if err != nil {
+		return false, err
+	}
+
+	return size <= order && 2*size <= maxSize, nil
+}
+
+// IsDense determines whether the given graph is dense, meaning that it has more
+// than half of the edges that a complete graph with the same number of vertices
+// would have. In a directed graph, this maximum number is twice as high as in an
+// undirected graph. Self-loops are not counted. A graph with less than two
+// vertices is never dense.
+func IsDense[K comparable, T any](g Graph[K, T]) (bool, error) {
+	_, size, maxSize, err := densityOf(g)
+	if err != nil {
+		return false, err
+	}
+
+	return maxSize > 0 && 2*size > maxSize, nil
+}
+
+// EigenvectorCentrality computes the eigenvector centrality of each vertex of the
+// given graph using power iteration. The centrality of a vertex is proportional
+// to the weighted sum of the centralities of its neighbors, or of the vertices of
+// its ingoing edges in a directed graph. The centralities are normalized to unit
+// length. For unweighted graphs, each edge has a weight of 1.
+//
+// The iteration stops once the centralities change by less than tol per vertex on
+// average, and returns an error if this doesn't happen within maxIter
+// iterations.
+func EigenvectorCentrality[K comparable, T any](g Graph[K, T], maxIter int, tol float64) (map[K]float64, error) {
+	if maxIter < 1 {
+		return nil, fmt.Errorf("maximum number of iterations must be at least 1, got %d", maxIter)
+	}
+
+	if tol <= 0 {
+		return nil, fmt.Errorf("tolerance must be positive, got %v", tol)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	centralities := make(map[K]float64, len(predecessorMap))
+	for vertex := range predecessorMap {
+		centralities[vertex] = 1 / float64(len(predecessorMap))
+	}
+
+	if len(centralities) == 0 {
+		return centralities, nil
+	}
+
+	weight := dijkstraEdgeWeight(g)
+
+	for i := 0; i < maxIter; i++ {
+		next := make(map[K]float64, len(centralities))
+
+		for vertex, predecessors := range predecessorMap {
+			next[vertex] = centralities[vertex]
+			for predecessor, edge := range predecessors {
+				next[vertex] += centralities[predecessor] * weight(edge)
+			}
+		}
+
+		norm := 0.0
+		for _, centrality := range next {
+			norm += centrality * centrality
+		}
+		norm = math.Sqrt(norm)
+
+		if norm == 0 {
+			return nil, fmt.Errorf("centralities vanished after %d iterations", i+1)
+		}
+
+		change := 0.0
+		for vertex := range next {
+			next[vertex] /= norm
+			change += math.Abs(next[vertex] - centralities[vertex])
+		}
+
+		centralities = next
+
+		if change < float64(len(centralities))*tol {
+			return centralities, nil
+		}
+	}
+
+	return nil, fmt.Errorf("eigenvector centrality did not converge within %d iterations", maxIter)
+}
+
+// jsonStats is the JSON representation of the statistics written by StatsJSON.
+type jsonStats struct {
+	Order           int         `json:"order"`
+	Size            int         `json:"size"`
+	IsDirected      bool        `json:"isDirected"`
+	Density         float64     `json:"density"`
+	AverageDegree   float64     `json:"averageDegree"`
+	MaxDegree       int         `json:"maxDegree"`
+	DegreeHistogram map[int]int `json:"degreeHistogram"`
+	Components      int         `json:"components"`
+	ComponentSizes  []int       `json:"componentSizes"`
+}
+
+// StatsJSON computes statistics of the given graph and writes them as JSON into
+// an io.Writer. The JSON document contains the fields order, size, isDirected,
+// density, averageDegree, maxDegree, degreeHistogram, components, and
+// componentSizes. For directed graphs, the components are the weakly connected
+// components.
+func StatsJSON[K comparable, T any](g Graph[K, T], w io.Writer) error {
+	size, err := g.Size()
+	if err != nil {
+		return fmt.Errorf("failed to get size: %w", err)
+	}
+
+	order, sizeWithoutSelfLoops, maxSize, err := densityOf(g)
+	if err != nil {
+		return err
+	}
+
+	degrees, err := DegreeSequence(g)
+	if err != nil {
+		return fmt.Errorf("failed to get degree sequence: %w", err)
+	}
+
+	histogram, err := DegreeHistogram(g)
+	if err != nil {
+		return fmt.Errorf("failed to get degree histogram: %w", err)
+	}
+
+	componentSizes, err := weakComponentSizes(g)
+	if err != nil {
+		return err
+	}
+
+	stats := jsonStats{
+		Order:           order,
+		Size:            size,
+		IsDirected:      g.Traits().IsDirected,
+		DegreeHistogram: histogram,
+		Components:      len(componentSizes),
+		ComponentSizes:  componentSizes,
+	}
+
+	if maxSize > 0 {
+		stats.Density = float64(sizeWithoutSelfLoops) / float64(maxSize)
+	}
+
+	if len(degrees) > 0 {
+		sum := 0
+		for _, degree := range degrees {
+			sum += degree
+		}
+		stats.AverageDegree = float64(sum) / float64(len(degrees))
+		stats.MaxDegree = degrees[0]
+	}
+
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		return fmt.Errorf("failed to encode statistics: %w", err)
+	}
+
+	return nil
+}
+
+// weakComponentSizes returns the number of vertices in each weakly connected
+// component of the given graph, sorted in descending order.
+func weakComponentSizes[K comparable, T any](g Graph[K, T]) ([]int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	components := newUnionFind(vertices...)
+	for _, edge := range edges {
+		components.union(edge.Source, edge.Target)
+	}
+
+	counts := make(map[K]int)
+	for _, vertex := range vertices {
+		counts[components.find(vertex)]++
+	}
+
+	sizes := make([]int, 0, len(counts))
+	for _, count := range counts {
+		sizes = append(sizes, count)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(sizes)))
+
+	return sizes, nil
+}
+
+// densityOf returns the order and the size of the given graph, excluding any
+// self-loops, as well as the size of a complete graph of the same order.
+func densityOf[K comparable, T any](g Graph[K, T]) (order, size, maxSize int, err error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	order = len(adjacencyMap)
+
+	for vertex, adjacencies := range adjacencyMap {
+		size += len(adjacencies)
+		if _, ok := adjacencies[vertex]; ok {
+			size--
+		}
+	}
+
+	maxSize = order * (order - 1)
+
+	// Each edge of an undirected graph is contained in the adjacency map twice.
+	if !g.Traits().IsDirected {
+		size /= 2
+		maxSize /= 2
+	}
+
+	return order, size, maxSize, nil
+}
+
+// aggregateWeights computes the sum, minimum, maximum, and mean of the given
+// weights in a single pass.
+func aggregateWeights(weights []int) (sum, min, max, mean float64) {
+	if len(weights) == 0 {
+		return 0, 0, 0, 0
+	}
+
+	min, max = float64(weights[0]), float64(weights[0])
+
+	for _, weight := range weights {
+		w := float64(weight)
+		sum += w
+		if w < min {
+			min = w
+		}
+		if w > max {
+			max = w
+		}
+	}
+
+	return sum, min, max, sum / float64(len(weights))
+}
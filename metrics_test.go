@@ -0,0 +1,752 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestAggregateEdgeWeights(t *testing.T) {
+	tests := map[string]struct {
+		traits       []func(*Traits)
+		edges        []Edge[string]
+		expectedSum  float64
+		expectedMin  float64
+		expectedMax  float64
+		expectedMean float64
+	}{
+		"weighted graph from mst.svg": {
+			traits: []func(*Traits){Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 4}},
+				{Source: "A", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 4}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 3}},
+			},
+			expectedSum:  16,
+			expectedMin:  1,
+			expectedMax:  4,
+			expectedMean: 16.0 / 6.0,
+		},
+		"directed graph with negative weight": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: -3}},
+				{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedSum:  2,
+			expectedMin:  -3,
+			expectedMax:  5,
+			expectedMean: 1,
+		},
+		"graph without edges": {
+			traits: []func(*Traits){},
+			edges:  []Edge[string]{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range []string{"A", "B", "C", "D"} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			sum, min, max, mean, err := AggregateEdgeWeights(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if sum != test.expectedSum {
+				t.Errorf("expected sum %v, got %v", test.expectedSum, sum)
+			}
+			if min != test.expectedMin {
+				t.Errorf("expected min %v, got %v", test.expectedMin, min)
+			}
+			if max != test.expectedMax {
+				t.Errorf("expected max %v, got %v", test.expectedMax, max)
+			}
+			if math.Abs(mean-test.expectedMean) > 1e-9 {
+				t.Errorf("expected mean %v, got %v", test.expectedMean, mean)
+			}
+		})
+	}
+}
+
+func TestAggregateVertexWeights(t *testing.T) {
+	g := New(StringHash)
+
+	_ = g.AddVertex("A", VertexWeight(3))
+	_ = g.AddVertex("B", VertexWeight(1))
+	_ = g.AddVertex("C", VertexWeight(8))
+
+	sum, min, max, mean, err := AggregateVertexWeights(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if sum != 12 || min != 1 || max != 8 || mean != 4 {
+		t.Errorf("expected (12, 1, 8, 4), got (%v, %v, %v, %v)", sum, min, max, mean)
+	}
+}
+
+func TestCyclomaticNumber(t *testing.T) {
+	tests := map[string]struct {
+		traits   []func(*Traits)
+		vertices []int
+		edges    []Edge[int]
+		expected int
+	}{
+		"tree": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expected: 0,
+		},
+		"single cycle": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expected: 1,
+		},
+		"directed graph with two components": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 5, Target: 6},
+			},
+			expected: 1,
+		},
+		"edgeless graph": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2},
+			expected: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			cyclomaticNumber, err := CyclomaticNumber(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if cyclomaticNumber != test.expected {
+				t.Errorf("expected cyclomatic number %d, got %d", test.expected, cyclomaticNumber)
+			}
+
+			cycles, _ := FundamentalCycles(g)
+
+			if len(cycles) != cyclomaticNumber {
+				t.Errorf("expected %d fundamental cycles, got %d", cyclomaticNumber, len(cycles))
+			}
+		})
+	}
+}
+
+func TestIsComplete(t *testing.T) {
+	tests := map[string]struct {
+		traits      []func(*Traits)
+		n           int
+		removedEdge *Edge[int]
+		addedEdges  []Edge[int]
+		expected    bool
+	}{
+		"undirected complete graph": {
+			traits:   []func(*Traits){},
+			n:        5,
+			expected: true,
+		},
+		"undirected complete graph without an edge": {
+			traits:      []func(*Traits){},
+			n:           5,
+			removedEdge: &Edge[int]{Source: 1, Target: 3},
+			expected:    false,
+		},
+		"directed complete graph": {
+			traits:   []func(*Traits){Directed()},
+			n:        4,
+			expected: true,
+		},
+		"directed complete graph without one direction": {
+			traits:      []func(*Traits){Directed()},
+			n:           4,
+			removedEdge: &Edge[int]{Source: 2, Target: 0},
+			expected:    false,
+		},
+		"missing edge compensated by self-loop": {
+			traits:      []func(*Traits){AllowSelfLoops()},
+			n:           4,
+			removedEdge: &Edge[int]{Source: 0, Target: 1},
+			addedEdges:  []Edge[int]{{Source: 2, Target: 2}},
+			expected:    false,
+		},
+		"single vertex": {
+			traits:   []func(*Traits){},
+			n:        1,
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			if err := CompleteGraph(g, test.n); err != nil {
+				t.Fatalf("failed to generate complete graph: %s", err.Error())
+			}
+
+			if test.removedEdge != nil {
+				if err := g.RemoveEdge(test.removedEdge.Source, test.removedEdge.Target); err != nil {
+					t.Fatalf("failed to remove edge: %s", err.Error())
+				}
+			}
+
+			for _, edge := range test.addedEdges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			isComplete, err := IsComplete(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if isComplete != test.expected {
+				t.Errorf("expected complete == %v, got %v", test.expected, isComplete)
+			}
+		})
+	}
+}
+
+func TestIsRegular(t *testing.T) {
+	tests := map[string]struct {
+		traits          []func(*Traits)
+		generate        func(g Graph[int, int]) error
+		expectedRegular bool
+		expectedDegree  int
+	}{
+		"cycle graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return CycleGraph(g, 5)
+			},
+			expectedRegular: true,
+			expectedDegree:  2,
+		},
+		"path graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return PathGraph(g, 5)
+			},
+			expectedRegular: false,
+			expectedDegree:  0,
+		},
+		"directed cycle graph": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				return CycleGraph(g, 4)
+			},
+			expectedRegular: true,
+			expectedDegree:  1,
+		},
+		"directed star graph": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				return StarGraph(g, 4)
+			},
+			expectedRegular: false,
+			expectedDegree:  0,
+		},
+		"complete graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return CompleteGraph(g, 4)
+			},
+			expectedRegular: true,
+			expectedDegree:  3,
+		},
+		"empty graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return nil
+			},
+			expectedRegular: true,
+			expectedDegree:  0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			if err := test.generate(g); err != nil {
+				t.Fatalf("failed to generate graph: %s", err.Error())
+			}
+
+			regular, degree, err := IsRegular(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if regular != test.expectedRegular {
+				t.Errorf("expected regular == %v, got %v", test.expectedRegular, regular)
+			}
+
+			if degree != test.expectedDegree {
+				t.Errorf("expected degree %d, got %d", test.expectedDegree, degree)
+			}
+		})
+	}
+}
+
+func TestDegreeSequence(t *testing.T) {
+	tests := map[string]struct {
+		traits            []func(*Traits)
+		generate          func(g Graph[int, int]) error
+		expectedSequence  []int
+		expectedHistogram map[int]int
+	}{
+		"undirected star graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return StarGraph(g, 5)
+			},
+			expectedSequence:  []int{4, 1, 1, 1, 1},
+			expectedHistogram: map[int]int{4: 1, 1: 4},
+		},
+		"directed path graph": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				return PathGraph(g, 4)
+			},
+			expectedSequence:  []int{2, 2, 1, 1},
+			expectedHistogram: map[int]int{2: 2, 1: 2},
+		},
+		"graph with isolated vertex": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				if err := CycleGraph(g, 3); err != nil {
+					return err
+				}
+				return g.AddVertex(3)
+			},
+			expectedSequence:  []int{2, 2, 2, 0},
+			expectedHistogram: map[int]int{2: 3, 0: 1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			if err := test.generate(g); err != nil {
+				t.Fatalf("failed to generate graph: %s", err.Error())
+			}
+
+			sequence, err := DegreeSequence(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(sequence) != len(test.expectedSequence) {
+				t.Fatalf("expected sequence %v, got %v", test.expectedSequence, sequence)
+			}
+
+			for i, degree := range test.expectedSequence {
+				if sequence[i] != degree {
+					t.Errorf("expected sequence %v, got %v", test.expectedSequence, sequence)
+					break
+				}
+			}
+
+			histogram, err := DegreeHistogram(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(histogram) != len(test.expectedHistogram) {
+				t.Fatalf("expected histogram %v, got %v", test.expectedHistogram, histogram)
+			}
+
+			for degree, count := range test.expectedHistogram {
+				if histogram[degree] != count {
+					t.Errorf("expected %d vertices with degree %d, got %d", count, degree, histogram[degree])
+				}
+			}
+
+			// The fast path of the memory store has to match the slow path.
+			degrees, _ := vertexDegrees[int, int](g)
+			expectedDegrees, _ := adjacencyMapDegrees[int, int](g)
+
+			for vertex, degree := range expectedDegrees {
+				if degrees[vertex] != degree {
+					t.Errorf("expected degree %d for %v, got %d", degree, vertex, degrees[vertex])
+				}
+			}
+		})
+	}
+}
+
+func TestIsSparse(t *testing.T) {
+	tests := map[string]struct {
+		traits         []func(*Traits)
+		generate       func(g Graph[int, int]) error
+		expectedSparse bool
+		expectedDense  bool
+	}{
+		"tree": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				if err := StarGraph(g, 6); err != nil {
+					return err
+				}
+				_ = g.AddVertex(6)
+				return g.AddEdge(1, 6)
+			},
+			expectedSparse: true,
+		},
+		"near-complete graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				if err := CompleteGraph(g, 6); err != nil {
+					return err
+				}
+				return g.RemoveEdge(0, 1)
+			},
+			expectedDense: true,
+		},
+		"near-complete directed graph": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				if err := CompleteGraph(g, 5); err != nil {
+					return err
+				}
+				return g.RemoveEdge(0, 1)
+			},
+			expectedDense: true,
+		},
+		"directed cycle": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				return CycleGraph(g, 5)
+			},
+			expectedSparse: true,
+		},
+		"grid graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return GridGraph(g, 3, 3)
+			},
+		},
+		"triangle": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return CycleGraph(g, 3)
+			},
+			expectedDense: true,
+		},
+		"single vertex": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return g.AddVertex(0)
+			},
+			expectedSparse: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			if err := test.generate(g); err != nil {
+				t.Fatalf("failed to generate graph: %s", err.Error())
+			}
+
+			sparse, err := IsSparse(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			dense, err := IsDense(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if sparse != test.expectedSparse {
+				t.Errorf("expected sparse == %v, got %v", test.expectedSparse, sparse)
+			}
+
+			if dense != test.expectedDense {
+				t.Errorf("expected dense == %v, got %v", test.expectedDense, dense)
+			}
+		})
+	}
+}
+
+func TestStatsJSON(t *testing.T) {
+	tests := map[string]struct {
+		traits                 []func(*Traits)
+		vertices               []int
+		edges                  []Edge[int]
+		expectedOrder          int
+		expectedSize           int
+		expectedComponents     int
+		expectedComponentSizes []int
+		expectedMaxDegree      int
+		expectedHistogram      map[string]int
+	}{
+		"undirected graph with two components": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 4, Target: 5},
+			},
+			expectedOrder:          5,
+			expectedSize:           3,
+			expectedComponents:     2,
+			expectedComponentSizes: []int{3, 2},
+			expectedMaxDegree:      2,
+			expectedHistogram:      map[string]int{"1": 4, "2": 1},
+		},
+		"directed graph with isolated vertex": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expectedOrder:          3,
+			expectedSize:           2,
+			expectedComponents:     2,
+			expectedComponentSizes: []int{2, 1},
+			expectedMaxDegree:      2,
+			expectedHistogram:      map[string]int{"0": 1, "2": 2},
+		},
+		"empty graph": {
+			traits:                 []func(*Traits){},
+			expectedComponentSizes: []int{},
+			expectedHistogram:      map[string]int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			var buf bytes.Buffer
+
+			if err := StatsJSON(g, &buf); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			var stats struct {
+				Order           int            `json:"order"`
+				Size            int            `json:"size"`
+				MaxDegree       int            `json:"maxDegree"`
+				DegreeHistogram map[string]int `json:"degreeHistogram"`
+				Components      int            `json:"components"`
+				ComponentSizes  []int          `json:"componentSizes"`
+			}
+
+			if err := json.Unmarshal(buf.Bytes(), &stats); err != nil {
+				t.Fatalf("failed to parse statistics %q: %s", buf.String(), err.Error())
+			}
+
+			if stats.Order != test.expectedOrder {
+				t.Errorf("expected order %d, got %d", test.expectedOrder, stats.Order)
+			}
+
+			if stats.Size != test.expectedSize {
+				t.Errorf("expected size %d, got %d", test.expectedSize, stats.Size)
+			}
+
+			if stats.Components != test.expectedComponents {
+				t.Errorf("expected %d components, got %d", test.expectedComponents, stats.Components)
+			}
+
+			if len(stats.ComponentSizes) != len(test.expectedComponentSizes) {
+				t.Fatalf("expected component sizes %v, got %v", test.expectedComponentSizes, stats.ComponentSizes)
+			}
+
+			for i, componentSize := range test.expectedComponentSizes {
+				if stats.ComponentSizes[i] != componentSize {
+					t.Errorf("expected component sizes %v, got %v", test.expectedComponentSizes, stats.ComponentSizes)
+					break
+				}
+			}
+
+			if stats.MaxDegree != test.expectedMaxDegree {
+				t.Errorf("expected max degree %d, got %d", test.expectedMaxDegree, stats.MaxDegree)
+			}
+
+			if len(stats.DegreeHistogram) != len(test.expectedHistogram) {
+				t.Fatalf("expected degree histogram %v, got %v", test.expectedHistogram, stats.DegreeHistogram)
+			}
+
+			for degree, count := range test.expectedHistogram {
+				if stats.DegreeHistogram[degree] != count {
+					t.Errorf("expected degree histogram %v, got %v", test.expectedHistogram, stats.DegreeHistogram)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestEigenvectorCentrality(t *testing.T) {
+	tests := map[string]struct {
+		traits               []func(*Traits)
+		generate             func(g Graph[int, int]) error
+		maxIter              int
+		tol                  float64
+		expectedCentralities map[int]float64
+		shouldFail           bool
+	}{
+		"star graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return StarGraph(g, 5)
+			},
+			maxIter: 1000,
+			tol:     1e-9,
+			expectedCentralities: map[int]float64{
+				0: 1 / math.Sqrt(2),
+				1: 1 / math.Sqrt(8),
+				2: 1 / math.Sqrt(8),
+				3: 1 / math.Sqrt(8),
+				4: 1 / math.Sqrt(8),
+			},
+		},
+		"complete graph": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return CompleteGraph(g, 4)
+			},
+			maxIter: 100,
+			tol:     1e-9,
+			expectedCentralities: map[int]float64{
+				0: 0.5, 1: 0.5, 2: 0.5, 3: 0.5,
+			},
+		},
+		"directed cycle": {
+			traits: []func(*Traits){Directed()},
+			generate: func(g Graph[int, int]) error {
+				return CycleGraph(g, 4)
+			},
+			maxIter: 100,
+			tol:     1e-9,
+			expectedCentralities: map[int]float64{
+				0: 0.5, 1: 0.5, 2: 0.5, 3: 0.5,
+			},
+		},
+		"weighted path": {
+			traits: []func(*Traits){Weighted()},
+			generate: func(g Graph[int, int]) error {
+				if err := addGeneratedVertices(g, 3); err != nil {
+					return err
+				}
+				if err := g.AddEdge(0, 1, EdgeWeight(3)); err != nil {
+					return err
+				}
+				return g.AddEdge(1, 2, EdgeWeight(4))
+			},
+			maxIter: 1000,
+			tol:     1e-12,
+			expectedCentralities: map[int]float64{
+				0: 3 / math.Sqrt(50), 1: 5 / math.Sqrt(50), 2: 4 / math.Sqrt(50),
+			},
+		},
+		"too few iterations": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return StarGraph(g, 5)
+			},
+			maxIter:    1,
+			tol:        1e-9,
+			shouldFail: true,
+		},
+		"invalid tolerance": {
+			traits: []func(*Traits){},
+			generate: func(g Graph[int, int]) error {
+				return StarGraph(g, 5)
+			},
+			maxIter:    100,
+			tol:        0,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			if err := test.generate(g); err != nil {
+				t.Fatalf("failed to generate graph: %s", err.Error())
+			}
+
+			centralities, err := EigenvectorCentrality(g, test.maxIter, test.tol)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			for vertex, expected := range test.expectedCentralities {
+				if math.Abs(centralities[vertex]-expected) > 1e-6 {
+					t.Errorf("expected centrality %v for %v, got %v", expected, vertex, centralities[vertex])
+				}
+			}
+		})
+	}
+}
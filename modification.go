@@ -0,0 +1,202 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RemoveVertices removes the vertices with the given hashes from the graph along
+// with all of their edges. In contrast to [Graph.RemoveVertex], the vertices
+// don't have to be disconnected. If one of the vertices doesn't exist, an error
+// wrapping ErrVertexNotFound will be returned and the graph remains unchanged.
+func RemoveVertices[K comparable, T any](g Graph[K, T], hashes []K) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	for _, hash := range hashes {
+		if _, ok := adjacencyMap[hash]; !ok {
+			return fmt.Errorf("failed to remove vertex %v: %w", hash, &VertexNotFoundError[K]{Key: hash})
+		}
+	}
+
+	removedVertices := make(map[K]struct{}, len(hashes))
+	removedEdges := make(map[EdgeKey[K]]struct{})
+
+	// An edge between two removed vertices is contained in the adjacency map of
+	// one vertex and the predecessor map of the other, and an undirected edge
+	// is contained in both directions. Each edge must only be removed once.
+	removeEdge := func(source, target K) error {
+		if _, ok := removedEdges[EdgeKey[K]{Source: source, Target: target}]; ok {
+			return nil
+		}
+		if _, ok := removedEdges[EdgeKey[K]{Source: target, Target: source}]; ok && !g.Traits().IsDirected {
+			return nil
+		}
+		if err := g.RemoveEdge(source, target); err != nil {
+			return fmt.Errorf("failed to remove edge (%v, %v): %w", source, target, err)
+		}
+		removedEdges[EdgeKey[K]{Source: source, Target: target}] = struct{}{}
+		return nil
+	}
+
+	for _, hash := range hashes {
+		if _, ok := removedVertices[hash]; ok {
+			continue
+		}
+
+		for adjacency := range adjacencyMap[hash] {
+			if err := removeEdge(hash, adjacency); err != nil {
+				return err
+			}
+		}
+
+		for predecessor := range predecessorMap[hash] {
+			if err := removeEdge(predecessor, hash); err != nil {
+				return err
+			}
+		}
+
+		if err := g.RemoveVertex(hash); err != nil {
+			return fmt.Errorf("failed to remove vertex %v: %w", hash, err)
+		}
+
+		removedVertices[hash] = struct{}{}
+	}
+
+	return nil
+}
+
+// RemoveEdges removes the given edges from the graph, ignoring their properties.
+// If ignoreMissing is false and one of the edges doesn't exist, an error wrapping
+// ErrEdgeNotFound will be returned before any edge is removed. If ignoreMissing
+// is true, edges that don't exist are skipped.
+func RemoveEdges[K comparable, T any](g Graph[K, T], edges []Edge[K], ignoreMissing bool) error {
+	// If the graph is able to remove the edges itself, use that fast path.
+	if rg, ok := g.(interface {
+		removeEdges(edges []Edge[K], ignoreMissing bool) error
+	}); ok {
+		if err := rg.removeEdges(edges, ignoreMissing); err != nil {
+			return fmt.Errorf("failed to remove edges: %w", err)
+		}
+		return nil
+	}
+
+	return removeEdgesOneByOne(g, edges, ignoreMissing)
+}
+
+// removeEdgesOneByOne is the slow path of RemoveEdges that works with any graph
+// implementation by removing one edge at a time.
+func removeEdgesOneByOne[K comparable, T any](g Graph[K, T], edges []Edge[K], ignoreMissing bool) error {
+	if !ignoreMissing {
+		for _, edge := range edges {
+			if _, err := g.Edge(edge.Source, edge.Target); err != nil {
+				return fmt.Errorf("failed to get edge (%v, %v): %w", edge.Source, edge.Target, err)
+			}
+		}
+	}
+
+	for _, edge := range edges {
+		// The edge might be missing or listed multiple times, and an undirected
+		// edge might be listed in both directions.
+		if _, err := g.Edge(edge.Source, edge.Target); errors.Is(err, ErrEdgeNotFound) {
+			continue
+		}
+		if err := g.RemoveEdge(edge.Source, edge.Target); err != nil {
+			return fmt.Errorf("failed to remove edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return nil
+}
+
+// Clear removes all vertices and edges from the graph, while the graph keeps
+// its hash function and traits.
+func Clear[K comparable, T any](g Graph[K, T]) error {
+	// If the graph is able to clear itself, use that fast path.
+	if cg, ok := g.(interface {
+		clear() error
+	}); ok {
+		if err := cg.clear(); err != nil {
+			return fmt.Errorf("failed to clear graph: %w", err)
+		}
+		return nil
+	}
+
+	return clearOneByOne(g)
+}
+
+// clearOneByOne is the slow path of Clear that works with any graph
+// implementation by removing one vertex at a time.
+func clearOneByOne[K comparable, T any](g Graph[K, T]) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	return RemoveVertices(g, vertices)
+}
+
+// TrimToReachable removes all vertices that aren't reachable from the given root
+// vertex along with their edges, so that only the root and its descendants
+// remain. The graph is modified in place. For an undirected graph, only the
+// connected component containing the root remains.
+//
+// If the root vertex doesn't exist, an error will be returned and the graph
+// remains unchanged.
+func TrimToReachable[K comparable, T any](g Graph[K, T], root K) error {
+	reachable, err := ReachableFromAny(g, []K{root})
+	if err != nil {
+		return fmt.Errorf("failed to determine reachable vertices: %w", err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	// A reachable vertex can't have an edge to an unreachable vertex, because
+	// that vertex would be reachable then. Hence, removing the outgoing edges
+	// of all unreachable vertices removes all of their edges.
+	removedEdges := make(map[EdgeKey[K]]struct{})
+
+	for vertex, adjacencies := range adjacencyMap {
+		if _, ok := reachable[vertex]; ok {
+			continue
+		}
+
+		for adjacency := range adjacencies {
+			// In an undirected graph, the edge might have been removed while
+			// processing the adjacent vertex already.
+			if _, ok := removedEdges[EdgeKey[K]{Source: adjacency, Target: vertex}]; ok && !g.Traits().IsDirected {
+				continue
+			}
+			if err := g.RemoveEdge(vertex, adjacency); err != nil {
+				return fmt.Errorf("failed to remove edge (%v, %v): %w", vertex, adjacency, err)
+			}
+			removedEdges[EdgeKey[K]{Source: vertex, Target: adjacency}] = struct{}{}
+		}
+	}
+
+	for vertex := range adjacencyMap {
+		if _, ok := reachable[vertex]; ok {
+			continue
+		}
+		if err := g.RemoveVertex(vertex); err != nil {
+			return fmt.Errorf("failed to remove vertex %v: %w", vertex, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,407 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRemoveVertices(t *testing.T) {
+	tests := map[string]struct {
+		traits           []func(*Traits)
+		vertices         []int
+		edges            []Edge[int]
+		hashes           []int
+		expectedVertices []int
+		expectedSize     int
+		expectedErr      error
+	}{
+		"directed graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 2},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 1},
+			},
+			hashes:           []int{2, 3},
+			expectedVertices: []int{1, 4, 5},
+			expectedSize:     2,
+		},
+		"undirected graph": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+			},
+			hashes:           []int{1, 3},
+			expectedVertices: []int{2, 4},
+			expectedSize:     0,
+		},
+		"self-loop and duplicate hashes": {
+			traits:   []func(*Traits){Directed(), AllowSelfLoops()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			hashes:           []int{1, 1},
+			expectedVertices: []int{2},
+			expectedSize:     0,
+		},
+		"no hashes": {
+			traits:           []func(*Traits){},
+			vertices:         []int{1, 2},
+			edges:            []Edge[int]{{Source: 1, Target: 2}},
+			hashes:           []int{},
+			expectedVertices: []int{1, 2},
+			expectedSize:     1,
+		},
+		"non-existent vertex": {
+			traits:           []func(*Traits){Directed()},
+			vertices:         []int{1, 2},
+			edges:            []Edge[int]{{Source: 1, Target: 2}},
+			hashes:           []int{1, 3},
+			expectedVertices: []int{1, 2},
+			expectedSize:     1,
+			expectedErr:      ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			err := RemoveVertices(g, test.hashes)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			adjacencyMap, _ := g.AdjacencyMap()
+
+			vertices := make([]int, 0, len(adjacencyMap))
+			for vertex := range adjacencyMap {
+				vertices = append(vertices, vertex)
+			}
+
+			if !slicesAreEqual(vertices, test.expectedVertices) {
+				t.Errorf("expected vertices %v, got %v", test.expectedVertices, vertices)
+			}
+
+			if size, _ := g.Size(); size != test.expectedSize {
+				t.Errorf("expected size %d, got %d", test.expectedSize, size)
+			}
+		})
+	}
+}
+
+func TestRemoveEdges(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		edges         []Edge[int]
+		removedEdges  []Edge[int]
+		ignoreMissing bool
+		expectedEdges []Edge[int]
+		expectedErr   error
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+			},
+			removedEdges: []Edge[int]{
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+		},
+		"undirected graph with edge in reverse direction": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			removedEdges: []Edge[int]{
+				{Source: 2, Target: 1},
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 2, Target: 3},
+			},
+		},
+		"missing edge": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			removedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedErr: ErrEdgeNotFound,
+		},
+		"ignored missing edge": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			removedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+				{Source: 1, Target: 2},
+			},
+			ignoreMissing: true,
+			expectedEdges: []Edge[int]{
+				{Source: 2, Target: 3},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		for _, observable := range []bool{false, true} {
+			subtest := name
+			if observable {
+				subtest += " (observable)"
+			}
+
+			t.Run(subtest, func(t *testing.T) {
+				var g Graph[int, int] = New(IntHash, test.traits...)
+
+				// An ObservableGraph doesn't provide a fast path for removing
+				// multiple edges, so it is used for testing the slow path.
+				if observable {
+					g = NewObservable(g)
+				}
+
+				for _, edge := range test.edges {
+					_ = g.AddVertex(edge.Source)
+					_ = g.AddVertex(edge.Target)
+
+					if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+						t.Fatalf("failed to add edge: %s", err.Error())
+					}
+				}
+
+				err := RemoveEdges(g, test.removedEdges, test.ignoreMissing)
+
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				if size, _ := g.Size(); size != len(test.expectedEdges) {
+					t.Errorf("expected size %d, got %d", len(test.expectedEdges), size)
+				}
+
+				for _, edge := range test.expectedEdges {
+					if _, err := g.Edge(edge.Source, edge.Target); err != nil {
+						t.Errorf("expected edge (%v, %v): %s", edge.Source, edge.Target, err.Error())
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestClear(t *testing.T) {
+	tests := map[string]struct {
+		traits []func(*Traits)
+		edges  []Edge[int]
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed(), AllowSelfLoops()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 2},
+			},
+		},
+		"undirected graph": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+		},
+		"empty graph": {
+			traits: []func(*Traits){Directed()},
+		},
+	}
+
+	for name, test := range tests {
+		for _, observable := range []bool{false, true} {
+			subtest := name
+			if observable {
+				subtest += " (observable)"
+			}
+
+			t.Run(subtest, func(t *testing.T) {
+				var g Graph[int, int] = New(IntHash, test.traits...)
+
+				// An ObservableGraph doesn't provide a fast path for clearing
+				// the graph, so it is used for testing the slow path.
+				if observable {
+					g = NewObservable(g)
+				}
+
+				for _, edge := range test.edges {
+					_ = g.AddVertex(edge.Source)
+					_ = g.AddVertex(edge.Target)
+
+					if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+						t.Fatalf("failed to add edge: %s", err.Error())
+					}
+				}
+
+				traits := *g.Traits()
+
+				if err := Clear(g); err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+
+				if order, _ := g.Order(); order != 0 {
+					t.Errorf("expected order 0, got %d", order)
+				}
+
+				if size, _ := g.Size(); size != 0 {
+					t.Errorf("expected size 0, got %d", size)
+				}
+
+				if !traitsAreEqual(g.Traits(), &traits) {
+					t.Errorf("expected traits %+v, got %+v", traits, g.Traits())
+				}
+
+				// The cleared graph has to be usable just like a new graph.
+				for _, edge := range test.edges {
+					_ = g.AddVertex(edge.Source)
+					_ = g.AddVertex(edge.Target)
+
+					if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+						t.Fatalf("failed to add edge after clearing: %s", err.Error())
+					}
+				}
+
+				if size, _ := g.Size(); size != len(test.edges) {
+					t.Errorf("expected size %d after re-adding edges, got %d", len(test.edges), size)
+				}
+			})
+		}
+	}
+}
+
+func TestTrimToReachable(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []int
+		edges         []Edge[int]
+		root          int
+		expectedOrder int
+		expectedSize  int
+		shouldFail    bool
+	}{
+		"undirected graph with disconnected component": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 4},
+			},
+			root:          1,
+			expectedOrder: 3,
+			expectedSize:  3,
+		},
+		"directed graph with edges towards reachable vertices": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 4, Target: 2},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 4},
+			},
+			root:          1,
+			expectedOrder: 3,
+			expectedSize:  2,
+		},
+		"non-existent root": {
+			traits:        []func(*Traits){Directed()},
+			vertices:      []int{1, 2},
+			edges:         []Edge[int]{{Source: 1, Target: 2}},
+			root:          3,
+			expectedOrder: 2,
+			expectedSize:  1,
+			shouldFail:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			err := TrimToReachable(g, test.root)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			order, _ := g.Order()
+			size, _ := g.Size()
+
+			if order != test.expectedOrder {
+				t.Errorf("expected order %d, got %d", test.expectedOrder, order)
+			}
+
+			if size != test.expectedSize {
+				t.Errorf("expected size %d, got %d", test.expectedSize, size)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if _, err := g.Vertex(test.root); err != nil {
+				t.Errorf("expected root to remain: %s", err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Observer is a set of callbacks that get invoked by an [ObservableGraph] after
+// the graph has been modified successfully. Callbacks that aren't needed can be
+// left nil.
+//
+// The callbacks are invoked synchronously by the goroutine that modified the
+// graph, in the order in which the observers have been registered.
+type Observer[K comparable, T any] struct {
+	// VertexAdded is invoked after a vertex has been added.
+	VertexAdded func(hash K, value T)
+
+	// VertexRemoved is invoked after a vertex has been removed.
+	VertexRemoved func(hash K)
+
+	// EdgeAdded is invoked after an edge has been added.
+	//
+	// In an undirected graph, the edge AB is the same as BA, and the edge passed
+	// to EdgeAdded, EdgeRemoved, and EdgeUpdated joins the vertices in the order
+	// they have been passed to the modifying method, not necessarily in the
+	// order returned by Edges. Observers of undirected graphs have to treat
+	// both directions as the same edge.
+	EdgeAdded func(edge Edge[K])
+
+	// EdgeRemoved is invoked after an edge has been removed. The edge holds the
+	// properties it had before its removal.
+	EdgeRemoved func(edge Edge[K])
+
+	// EdgeUpdated is invoked after an edge has been updated. The edge holds the
+	// new properties.
+	EdgeUpdated func(edge Edge[K])
+}
+
+// ObservableGraph wraps a graph and notifies the registered observers about all
+// modifications that succeeded and actually changed the graph, so adding an
+// existing vertex or edge with the AllowDuplicateAdd trait doesn't invoke any
+// callbacks. Modifications made to the wrapped graph directly are not observed.
+//
+//	g := graph.NewObservable(graph.New(graph.StringHash))
+//
+//	g.Observe(graph.Observer[string, string]{
+//		VertexAdded: func(hash string, value string) {
+//			fmt.Println("added", hash)
+//		},
+//	})
+//
+// Clone returns a clone of the wrapped graph without any observers.
+type ObservableGraph[K comparable, T any] struct {
+	Graph[K, T]
+	hash      Hash[K, T]
+	lock      sync.RWMutex
+	observers []Observer[K, T]
+}
+
+// NewObservable creates an [ObservableGraph] wrapping the given graph, which has
+// to be created by New or NewWithStore.
+func NewObservable[K comparable, T any](g Graph[K, T]) *ObservableGraph[K, T] {
+	return &ObservableGraph[K, T]{
+		Graph: g,
+		hash:  hashOf(g),
+	}
+}
+
+// Observe registers the given observer. It is safe to call Observe concurrently
+// with other methods of the graph.
+func (o *ObservableGraph[K, T]) Observe(observer Observer[K, T]) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.observers = append(o.observers, observer)
+}
+
+func (o *ObservableGraph[K, T]) AddVertex(value T, options ...func(*VertexProperties)) error {
+	hash := o.hash(value)
+	_, existsErr := o.Graph.Vertex(hash)
+
+	if err := o.Graph.AddVertex(value, options...); err != nil {
+		return err
+	}
+
+	if existsErr == nil {
+		return nil
+	}
+
+	for _, observer := range o.currentObservers() {
+		if observer.VertexAdded != nil {
+			observer.VertexAdded(hash, value)
+		}
+	}
+
+	return nil
+}
+
+func (o *ObservableGraph[K, T]) AddVerticesFrom(g Graph[K, T]) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for hash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		if err = o.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *ObservableGraph[K, T]) RemoveVertex(hash K) error {
+	if err := o.Graph.RemoveVertex(hash); err != nil {
+		return err
+	}
+
+	for _, observer := range o.currentObservers() {
+		if observer.VertexRemoved != nil {
+			observer.VertexRemoved(hash)
+		}
+	}
+
+	return nil
+}
+
+func (o *ObservableGraph[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
+	_, existsErr := o.Graph.Edge(sourceHash, targetHash)
+
+	if err := o.Graph.AddEdge(sourceHash, targetHash, options...); err != nil {
+		return err
+	}
+
+	if existsErr == nil {
+		return nil
+	}
+
+	return o.notifyEdge(sourceHash, targetHash, func(observer Observer[K, T]) func(Edge[K]) {
+		return observer.EdgeAdded
+	})
+}
+
+func (o *ObservableGraph[K, T]) AddEdgesFrom(g Graph[K, T]) error {
+	edges, err := g.Edges()
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	// AddEdgesFrom of the wrapped graph is atomic, so all edges that don't
+	// exist yet will have been added if it succeeds.
+	newEdges := make([]Edge[K], 0, len(edges))
+	for _, edge := range edges {
+		if _, err := o.Graph.Edge(edge.Source, edge.Target); err != nil {
+			newEdges = append(newEdges, edge)
+		}
+	}
+
+	if err := o.Graph.AddEdgesFrom(g); err != nil {
+		return err
+	}
+
+	for _, edge := range newEdges {
+		err := o.notifyEdge(edge.Source, edge.Target, func(observer Observer[K, T]) func(Edge[K]) {
+			return observer.EdgeAdded
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ObservableGraph[K, T]) UpdateEdge(source, target K, options ...func(properties *EdgeProperties)) error {
+	if err := o.Graph.UpdateEdge(source, target, options...); err != nil {
+		return err
+	}
+
+	return o.notifyEdge(source, target, func(observer Observer[K, T]) func(Edge[K]) {
+		return observer.EdgeUpdated
+	})
+}
+
+func (o *ObservableGraph[K, T]) RemoveEdge(source, target K) error {
+	edge, err := o.Graph.Edge(source, target)
+	if err != nil {
+		return err
+	}
+
+	if err := o.Graph.RemoveEdge(source, target); err != nil {
+		return err
+	}
+
+	removedEdge := Edge[K]{
+		Source:     source,
+		Target:     target,
+		Properties: edge.Properties,
+	}
+
+	for _, observer := range o.currentObservers() {
+		if observer.EdgeRemoved != nil {
+			observer.EdgeRemoved(removedEdge)
+		}
+	}
+
+	return nil
+}
+
+// notifyEdge obtains the current edge joining the given vertices and passes it
+// to the callback selected from each observer.
+func (o *ObservableGraph[K, T]) notifyEdge(source, target K, callback func(Observer[K, T]) func(Edge[K])) error {
+	edge, err := o.Graph.Edge(source, target)
+	if err != nil {
+		return fmt.Errorf("failed to get edge (%v, %v): %w", source, target, err)
+	}
+
+	notifiedEdge := Edge[K]{
+		Source:     source,
+		Target:     target,
+		Properties: edge.Properties,
+	}
+
+	for _, observer := range o.currentObservers() {
+		if f := callback(observer); f != nil {
+			f(notifiedEdge)
+		}
+	}
+
+	return nil
+}
+
+// currentObservers returns a copy of the registered observers, so that the
+// callbacks can be invoked without holding the lock.
+func (o *ObservableGraph[K, T]) currentObservers() []Observer[K, T] {
+	o.lock.RLock()
+	defer o.lock.RUnlock()
+
+	observers := make([]Observer[K, T], len(o.observers))
+	copy(observers, o.observers)
+
+	return observers
+}
@@ -0,0 +1,205 @@
+package graph
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestObservableGraph(t *testing.T) {
+	tests := map[string]struct {
+		traits []func(*Traits)
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed()},
+		},
+		"undirected graph with duplicates": {
+			traits: []func(*Traits){AllowDuplicateAdd()},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewObservable(New(IntHash, test.traits...))
+
+			var events []string
+
+			g.Observe(Observer[int, int]{
+				VertexAdded: func(hash int, value int) {
+					events = append(events, "vertex added")
+				},
+				VertexRemoved: func(hash int) {
+					events = append(events, "vertex removed")
+				},
+				EdgeAdded: func(edge Edge[int]) {
+					events = append(events, "edge added")
+				},
+				EdgeUpdated: func(edge Edge[int]) {
+					if edge.Properties.Weight != 5 {
+						t.Errorf("expected updated weight 5, got %d", edge.Properties.Weight)
+					}
+					events = append(events, "edge updated")
+				},
+				EdgeRemoved: func(edge Edge[int]) {
+					if edge.Source != 1 || edge.Target != 2 {
+						t.Errorf("expected removed edge (1, 2), got (%v, %v)", edge.Source, edge.Target)
+					}
+					events = append(events, "edge removed")
+				},
+			})
+
+			_ = g.AddVertex(1)
+			_ = g.AddVertex(2)
+			_ = g.AddVertex(1)
+			_ = g.AddEdge(1, 2)
+			_ = g.AddEdge(1, 2)
+			_ = g.AddEdge(1, 3)
+			_ = g.UpdateEdge(1, 2, EdgeWeight(5))
+			_ = g.RemoveEdge(1, 2)
+			_ = g.RemoveVertex(2)
+
+			expectedEvents := []string{
+				"vertex added",
+				"vertex added",
+				"edge added",
+				"edge updated",
+				"edge removed",
+				"vertex removed",
+			}
+
+			if len(events) != len(expectedEvents) {
+				t.Fatalf("expected events %v, got %v", expectedEvents, events)
+			}
+
+			for i, event := range expectedEvents {
+				if events[i] != event {
+					t.Errorf("expected event %v at index %d, got %v", event, i, events[i])
+				}
+			}
+		})
+	}
+}
+
+func TestObservableGraph_undirectedEdgeOrder(t *testing.T) {
+	g := NewObservable(New(IntHash))
+
+	var added, updated, removed Edge[int]
+
+	g.Observe(Observer[int, int]{
+		EdgeAdded: func(edge Edge[int]) {
+			added = edge
+		},
+		EdgeUpdated: func(edge Edge[int]) {
+			updated = edge
+		},
+		EdgeRemoved: func(edge Edge[int]) {
+			removed = edge
+		},
+	})
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2)
+	_ = g.UpdateEdge(2, 1, EdgeWeight(5))
+	_ = g.RemoveEdge(2, 1)
+
+	// The edges are reported in the order of the arguments.
+	if added.Source != 1 || added.Target != 2 {
+		t.Errorf("expected added edge (1, 2), got (%v, %v)", added.Source, added.Target)
+	}
+
+	if updated.Source != 2 || updated.Target != 1 || updated.Properties.Weight != 5 {
+		t.Errorf("expected updated edge (2, 1) with weight 5, got (%v, %v) with weight %d", updated.Source, updated.Target, updated.Properties.Weight)
+	}
+
+	if removed.Source != 2 || removed.Target != 1 {
+		t.Errorf("expected removed edge (2, 1), got (%v, %v)", removed.Source, removed.Target)
+	}
+}
+
+func TestObservableGraph_AddFrom(t *testing.T) {
+	source := New(IntHash, Directed())
+	_ = source.AddVertex(1)
+	_ = source.AddVertex(2)
+	_ = source.AddEdge(1, 2)
+
+	g := NewObservable(New(IntHash, Directed(), PreventCycles()))
+
+	addedVertices := 0
+	addedEdges := 0
+
+	g.Observe(Observer[int, int]{
+		VertexAdded: func(hash int, value int) {
+			addedVertices++
+		},
+		EdgeAdded: func(edge Edge[int]) {
+			addedEdges++
+		},
+	})
+
+	if err := g.AddVerticesFrom(source); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if err := g.AddEdgesFrom(source); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if addedVertices != 2 || addedEdges != 1 {
+		t.Errorf("expected 2 added vertices and 1 added edge, got %d and %d", addedVertices, addedEdges)
+	}
+
+	// A failing modification must not invoke any callbacks.
+	cycle := New(IntHash, Directed())
+	_ = cycle.AddVertex(1)
+	_ = cycle.AddVertex(2)
+	_ = cycle.AddEdge(2, 1)
+
+	if err := g.AddEdgesFrom(cycle); !errors.Is(err, ErrEdgeCreatesCycle) {
+		t.Fatalf("expected error %v, got %v", ErrEdgeCreatesCycle, err)
+	}
+
+	if addedEdges != 1 {
+		t.Errorf("expected no edge to be added, got %d added edges", addedEdges)
+	}
+}
+
+func TestObservableGraph_concurrentObserve(t *testing.T) {
+	g := NewObservable(New(IntHash))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			g.Observe(Observer[int, int]{})
+		}()
+
+		go func(i int) {
+			defer wg.Done()
+			_ = g.AddVertex(i)
+		}(i)
+	}
+
+	wg.Wait()
+
+	if order, _ := g.Order(); order != 10 {
+		t.Errorf("expected order 10, got %d", order)
+	}
+}
+
+func TestObservableGraph_NewLike(t *testing.T) {
+	g := NewObservable(New(StringHash, Directed()))
+
+	h := NewLike[string, string](g)
+
+	if !traitsAreEqual(g.Traits(), h.Traits()) {
+		t.Errorf("expected traits %v, got %v", g.Traits(), h.Traits())
+	}
+
+	if err := h.AddVertex("A"); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+}
@@ -0,0 +1,586 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// KernighanLinBisection splits the vertices of the given graph into two sets of
+// nearly equal size using the Kernighan-Lin heuristic, so that the total weight
+// of the edges between the sets is small. It returns the set of each vertex,
+// which is either 0 or 1, along with the weight of the cut. Edge directions are
+// ignored, and for unweighted graphs, each edge has a weight of 1.
+func KernighanLinBisection[K comparable, T any](g Graph[K, T]) (map[K]int, int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return keyLess(vertices[i], vertices[j])
+	})
+
+	costs := newPartitionCosts(g, vertices, edges)
+
+	sets := make([]int, len(vertices))
+	for i := len(vertices) / 2; i < len(vertices); i++ {
+		sets[i] = 1
+	}
+
+	costs.kernighanLin(sets)
+
+	result := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		result[vertex] = sets[i]
+	}
+
+	return result, costs.cut(sets), nil
+}
+
+// Partition splits the vertices of the given graph into the given number of
+// parts of nearly equal size using a multilevel heuristic, so that the total
+// weight of the edges between different parts is small. It returns the part of
+// each vertex, which is a number from 0 to parts-1. Edge directions are ignored,
+// and for unweighted graphs, each edge has a weight of 1.
+func Partition[K comparable, T any](g Graph[K, T], parts int) (map[K]int, error) {
+	if parts < 1 {
+		return nil, fmt.Errorf("number of parts must be at least 1, got %d", parts)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return keyLess(vertices[i], vertices[j])
+	})
+
+	vertexWeights := make([]int, len(vertices))
+	for i := range vertexWeights {
+		vertexWeights[i] = 1
+	}
+
+	// A part may be 5% larger than the average part, and a coarse vertex may
+	// not be larger than the average part so that it fits into any part.
+	maxPartWeight := (21*len(vertices) + 20*parts - 1) / (20 * parts)
+	maxVertexWeight := (len(vertices) + parts - 1) / parts
+
+	levels := []partitionLevel{
+		{
+			costs:         newPartitionCosts(g, vertices, edges),
+			vertexWeights: vertexWeights,
+		},
+	}
+
+	for {
+		current := &levels[len(levels)-1]
+		if len(current.vertexWeights) <= 4*parts {
+			break
+		}
+
+		coarse := current.coarsen(maxVertexWeight)
+		if len(coarse.vertexWeights) == len(current.vertexWeights) {
+			break
+		}
+
+		levels = append(levels, coarse)
+	}
+
+	coarsest := levels[len(levels)-1]
+	assignment := coarsest.grow(parts)
+	coarsest.refine(assignment, parts, maxPartWeight)
+
+	for i := len(levels) - 2; i >= 0; i-- {
+		projected := make([]int, len(levels[i].vertexWeights))
+		for v := range projected {
+			projected[v] = assignment[levels[i].coarseVertices[v]]
+		}
+
+		assignment = projected
+		levels[i].refine(assignment, parts, maxPartWeight)
+	}
+
+	result := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		result[vertex] = assignment[i]
+	}
+
+	return result, nil
+}
+
+// GlobalMinCut computes a minimum cut of the given undirected graph using the
+// Stoer-Wagner algorithm, i.e. a split of its vertices into two non-empty sets
+// with a minimal total weight of the edges between them. It returns one of the
+// sets along with the weight of the cut.
+//
+// If the weight function is nil, the edge weights are used, and each edge of an
+// unweighted graph has a weight of 1. Weights must not be negative. The graph
+// needs at least two vertices.
+func GlobalMinCut[K comparable, T any](g Graph[K, T], weight func(Edge[K]) float64) ([]K, float64, error) {
+	if g.Traits().IsDirected {
+		return nil, 0, errors.New("global minimum cut can only be computed on undirected graphs")
+	}
+
+	if weight == nil {
+		weight = dijkstraEdgeWeight(g)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	if len(adjacencyMap) < 2 {
+		return nil, 0, errors.New("global minimum cut requires at least two vertices")
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return keyLess(vertices[i], vertices[j])
+	})
+
+	indices := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		indices[vertex] = i
+	}
+
+	n := len(vertices)
+
+	weights := make([][]float64, n)
+	for i := range weights {
+		weights[i] = make([]float64, n)
+	}
+
+	for _, edge := range edges {
+		if edge.Source == edge.Target {
+			continue
+		}
+		source, target := indices[edge.Source], indices[edge.Target]
+		weights[source][target] += weight(edge)
+		weights[target][source] += weight(edge)
+	}
+
+	// Each remaining vertex represents a group of original vertices that have
+	// been merged into it.
+	groups := make([][]int, n)
+	active := make([]int, n)
+	for i := range groups {
+		groups[i] = []int{i}
+		active[i] = i
+	}
+
+	var bestCut []int
+	bestWeight := 0.0
+
+	for len(active) > 1 {
+		// Add the vertices to a growing set in maximum adjacency order, i.e.
+		// always add the vertex most strongly connected to the set.
+		added := make([]bool, n)
+		connectivity := make([]float64, n)
+		previous, last := -1, -1
+
+		for range active {
+			next := -1
+			for _, v := range active {
+				if !added[v] && (next == -1 || connectivity[v] > connectivity[next]) {
+					next = v
+				}
+			}
+
+			added[next] = true
+			previous, last = last, next
+
+			for _, v := range active {
+				if !added[v] {
+					connectivity[v] += weights[next][v]
+				}
+			}
+		}
+
+		// The cut separating the last vertex from all other vertices is the
+		// cut of this phase.
+		if bestCut == nil || connectivity[last] < bestWeight {
+			bestCut = append([]int{}, groups[last]...)
+			bestWeight = connectivity[last]
+		}
+
+		// Merge the last vertex into the previous one.
+		groups[previous] = append(groups[previous], groups[last]...)
+		for _, v := range active {
+			weights[previous][v] += weights[last][v]
+			weights[v][previous] = weights[previous][v]
+		}
+		weights[previous][previous] = 0
+
+		for i, v := range active {
+			if v == last {
+				active = append(active[:i], active[i+1:]...)
+				break
+			}
+		}
+	}
+
+	cut := make([]K, len(bestCut))
+	for i, v := range bestCut {
+		cut[i] = vertices[v]
+	}
+
+	return cut, bestWeight, nil
+}
+
+// partitionLevel is a level of the multilevel partitioning scheme. Each vertex
+// of a coarse level represents one or two vertices of the next finer level, and
+// its weight is the number of original vertices it represents.
+type partitionLevel struct {
+	costs         *partitionCosts
+	vertexWeights []int
+
+	// coarseVertices maps each vertex to the vertex representing it on the next
+	// coarser level. It is only set once the level has been coarsened.
+	coarseVertices []int
+}
+
+// coarsen merges pairs of adjacent vertices and returns the resulting coarser
+// level. Each vertex is merged with the unmatched neighbor it is joined with by
+// the heaviest edge, as long as their weights don't exceed maxVertexWeight.
+func (l *partitionLevel) coarsen(maxVertexWeight int) partitionLevel {
+	n := len(l.vertexWeights)
+
+	matches := make([]int, n)
+	for v := range matches {
+		matches[v] = -1
+	}
+
+	for v := 0; v < n; v++ {
+		if matches[v] != -1 {
+			continue
+		}
+
+		match, matchWeight := v, 0
+
+		for u, weight := range l.costs.weights[v] {
+			if matches[u] != -1 || l.vertexWeights[u]+l.vertexWeights[v] > maxVertexWeight {
+				continue
+			}
+			if match == v || weight > matchWeight || weight == matchWeight && u < match {
+				match, matchWeight = u, weight
+			}
+		}
+
+		matches[v], matches[match] = match, v
+	}
+
+	l.coarseVertices = make([]int, n)
+	coarseWeights := make([]int, 0, n)
+
+	for v := 0; v < n; v++ {
+		if matches[v] < v {
+			l.coarseVertices[v] = l.coarseVertices[matches[v]]
+			coarseWeights[l.coarseVertices[v]] += l.vertexWeights[v]
+			continue
+		}
+		l.coarseVertices[v] = len(coarseWeights)
+		coarseWeights = append(coarseWeights, l.vertexWeights[v])
+	}
+
+	weights := make([]map[int]int, len(coarseWeights))
+	for i := range weights {
+		weights[i] = make(map[int]int)
+	}
+
+	for v, adjacencies := range l.costs.weights {
+		for u, weight := range adjacencies {
+			if l.coarseVertices[u] != l.coarseVertices[v] {
+				weights[l.coarseVertices[v]][l.coarseVertices[u]] += weight
+			}
+		}
+	}
+
+	return partitionLevel{
+		costs:         &partitionCosts{weights: weights},
+		vertexWeights: coarseWeights,
+	}
+}
+
+// grow computes an initial partition by growing one part after another. Each
+// part starts with the first unassigned vertex and repeatedly takes the
+// unassigned vertex most strongly connected to it until it has reached its
+// share of the remaining weight. The last part takes all remaining vertices.
+func (l *partitionLevel) grow(parts int) []int {
+	assignment := make([]int, len(l.vertexWeights))
+	for v := range assignment {
+		assignment[v] = -1
+	}
+
+	remaining := 0
+	for _, weight := range l.vertexWeights {
+		remaining += weight
+	}
+
+	for part := 0; part < parts-1; part++ {
+		target := (remaining + (parts-part)/2) / (parts - part)
+		partWeight := 0
+
+		// connectivity holds the total weight of the edges joining each
+		// unassigned vertex with the current part.
+		connectivity := make(map[int]int)
+
+		for partWeight < target {
+			next, found := -1, false
+
+			for v, weight := range connectivity {
+				if !found || weight > connectivity[next] || weight == connectivity[next] && v < next {
+					next, found = v, true
+				}
+			}
+
+			if !found {
+				for v, assigned := range assignment {
+					if assigned == -1 {
+						next, found = v, true
+						break
+					}
+				}
+			}
+
+			if !found {
+				break
+			}
+
+			assignment[next] = part
+			partWeight += l.vertexWeights[next]
+			remaining -= l.vertexWeights[next]
+			delete(connectivity, next)
+
+			for u, weight := range l.costs.weights[next] {
+				if assignment[u] == -1 {
+					connectivity[u] += weight
+				}
+			}
+		}
+	}
+
+	for v, assigned := range assignment {
+		if assigned == -1 {
+			assignment[v] = parts - 1
+		}
+	}
+
+	return assignment
+}
+
+// refine improves the given partition in place by moving single vertices to
+// the part they are most strongly connected to, as long as this reduces the cut
+// and the part doesn't exceed maxPartWeight.
+func (l *partitionLevel) refine(assignment []int, parts, maxPartWeight int) {
+	partWeights := make([]int, parts)
+	for v, part := range assignment {
+		partWeights[part] += l.vertexWeights[v]
+	}
+
+	for moved := true; moved; {
+		moved = false
+
+		for v, from := range assignment {
+			connectivity := make([]int, parts)
+			for u, weight := range l.costs.weights[v] {
+				connectivity[assignment[u]] += weight
+			}
+
+			to, bestGain := from, 0
+
+			for part := range connectivity {
+				gain := connectivity[part] - connectivity[from]
+				if gain > bestGain && partWeights[part]+l.vertexWeights[v] <= maxPartWeight {
+					to, bestGain = part, gain
+				}
+			}
+
+			if to != from {
+				assignment[v] = to
+				partWeights[from] -= l.vertexWeights[v]
+				partWeights[to] += l.vertexWeights[v]
+				moved = true
+			}
+		}
+	}
+}
+
+// partitionCosts holds the weights of the edges joining the vertices of a graph,
+// where each vertex has been replaced with its index. The edges are undirected,
+// so the weight of (A,B) equals the weight of (B,A).
+type partitionCosts struct {
+	weights []map[int]int
+}
+
+func newPartitionCosts[K comparable, T any](g Graph[K, T], vertices []K, edges []Edge[K]) *partitionCosts {
+	indices := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		indices[vertex] = i
+	}
+
+	weights := make([]map[int]int, len(vertices))
+	for i := range weights {
+		weights[i] = make(map[int]int)
+	}
+
+	for _, edge := range edges {
+		if edge.Source == edge.Target {
+			continue
+		}
+
+		weight := 1
+		if g.Traits().IsWeighted {
+			weight = edge.Properties.Weight
+		}
+
+		source, target := indices[edge.Source], indices[edge.Target]
+		weights[source][target] += weight
+		weights[target][source] += weight
+	}
+
+	return &partitionCosts{
+		weights: weights,
+	}
+}
+
+// cut returns the total weight of all edges joining vertices of different sets.
+func (p *partitionCosts) cut(sets []int) int {
+	cut := 0
+
+	for source, adjacencies := range p.weights {
+		for target, weight := range adjacencies {
+			if source < target && sets[source] != sets[target] {
+				cut += weight
+			}
+		}
+	}
+
+	return cut
+}
+
+// kernighanLin improves the given bisection in place by running passes of the
+// Kernighan-Lin heuristic until a pass doesn't reduce the cut anymore.
+func (p *partitionCosts) kernighanLin(sets []int) {
+	for p.kernighanLinPass(sets) {
+	}
+}
+
+// kernighanLinPass runs a single pass of the Kernighan-Lin heuristic. It
+// tentatively swaps pairs of vertices until all vertices of the smaller set have
+// been swapped, and then applies the prefix of swaps with the largest total
+// gain. It reports whether the cut has been reduced.
+func (p *partitionCosts) kernighanLinPass(sets []int) bool {
+	n := len(sets)
+
+	// differences[v] is the external cost of v minus its internal cost, i.e. the
+	// reduction of the cut if v was moved to the other set.
+	differences := make([]int, n)
+	for v, adjacencies := range p.weights {
+		for w, weight := range adjacencies {
+			if sets[v] == sets[w] {
+				differences[v] -= weight
+			} else {
+				differences[v] += weight
+			}
+		}
+	}
+
+	locked := make([]bool, n)
+	swaps := make([][2]int, 0)
+	gains := make([]int, 0)
+
+	for {
+		a, b, gain, ok := p.bestSwap(sets, locked, differences)
+		if !ok {
+			break
+		}
+
+		locked[a], locked[b] = true, true
+		swaps = append(swaps, [2]int{a, b})
+		gains = append(gains, gain)
+
+		// Update the differences of the unlocked vertices as if a and b had
+		// been swapped.
+		for v := range sets {
+			if locked[v] {
+				continue
+			}
+			if sets[v] == sets[a] {
+				differences[v] += 2*p.weights[v][a] - 2*p.weights[v][b]
+			} else {
+				differences[v] += 2*p.weights[v][b] - 2*p.weights[v][a]
+			}
+		}
+	}
+
+	bestGain, bestCount, gain := 0, 0, 0
+	for i, g := range gains {
+		gain += g
+		if gain > bestGain {
+			bestGain, bestCount = gain, i+1
+		}
+	}
+
+	for _, swap := range swaps[:bestCount] {
+		sets[swap[0]], sets[swap[1]] = sets[swap[1]], sets[swap[0]]
+	}
+
+	return bestGain > 0
+}
+
+// bestSwap finds the pair of unlocked vertices from set 0 and set 1 whose swap
+// reduces the cut the most, based on the given differences.
+func (p *partitionCosts) bestSwap(sets []int, locked []bool, differences []int) (int, int, int, bool) {
+	bestA, bestB, bestGain, found := 0, 0, 0, false
+
+	for a := range sets {
+		if locked[a] || sets[a] != 0 {
+			continue
+		}
+		for b := range sets {
+			if locked[b] || sets[b] != 1 {
+				continue
+			}
+
+			gain := differences[a] + differences[b] - 2*p.weights[a][b]
+
+			if !found || gain > bestGain {
+				bestA, bestB, bestGain, found = a, b, gain, true
+			}
+		}
+	}
+
+	return bestA, bestB, bestGain, found
+}
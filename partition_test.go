@@ -0,0 +1,355 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestKernighanLinBisection(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []int
+		edges         []Edge[int]
+		expectedSets  [][]int
+		expectedCut   int
+		expectedSizes [2]int
+	}{
+		"two cliques joined by a bridge": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4, 5, 6, 7, 8},
+			edges: append(append(
+				cliqueEdges([]int{1, 3, 5, 7}),
+				cliqueEdges([]int{2, 4, 6, 8})...),
+				Edge[int]{Source: 7, Target: 2},
+			),
+			expectedSets:  [][]int{{1, 3, 5, 7}, {2, 4, 6, 8}},
+			expectedCut:   1,
+			expectedSizes: [2]int{4, 4},
+		},
+		"weighted path": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 5}},
+				{Source: 3, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedSets:  [][]int{{1, 3}, {2, 4}},
+			expectedCut:   1,
+			expectedSizes: [2]int{2, 2},
+		},
+		"directed cliques with odd number of vertices": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 4},
+				{Source: 4, Target: 1},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 5},
+				{Source: 5, Target: 2},
+			},
+			expectedSets:  [][]int{{1, 4}, {2, 3, 5}},
+			expectedCut:   0,
+			expectedSizes: [2]int{2, 3},
+		},
+		"empty graph": {
+			traits:        []func(*Traits){},
+			expectedCut:   0,
+			expectedSizes: [2]int{0, 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			sets, cut, err := KernighanLinBisection(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if cut != test.expectedCut {
+				t.Errorf("expected cut %d, got %d", test.expectedCut, cut)
+			}
+
+			sizes := [2]int{}
+			for _, set := range sets {
+				sizes[set]++
+			}
+
+			if sizes != test.expectedSizes {
+				t.Errorf("expected set sizes %v, got %v", test.expectedSizes, sizes)
+			}
+
+			// The vertices that belong together have to end up in the same set,
+			// regardless of which set that is.
+			for _, expectedSet := range test.expectedSets {
+				for _, vertex := range expectedSet {
+					if sets[vertex] != sets[expectedSet[0]] {
+						t.Errorf("expected %v and %v to be in the same set, got %v", vertex, expectedSet[0], sets)
+					}
+				}
+			}
+		})
+	}
+}
+
+// cliqueEdges returns the edges of a complete graph over the given vertices.
+func cliqueEdges(vertices []int) []Edge[int] {
+	edges := make([]Edge[int], 0)
+
+	for i, source := range vertices {
+		for _, target := range vertices[i+1:] {
+			edges = append(edges, Edge[int]{Source: source, Target: target})
+		}
+	}
+
+	return edges
+}
+
+func TestPartition(t *testing.T) {
+	tests := map[string]struct {
+		clusters    int
+		clusterSize int
+		parts       int
+	}{
+		"ring of 4 clusters": {
+			clusters:    4,
+			clusterSize: 5,
+			parts:       4,
+		},
+		"ring of 3 clusters": {
+			clusters:    3,
+			clusterSize: 8,
+			parts:       3,
+		},
+		"ring of 6 clusters": {
+			clusters:    6,
+			clusterSize: 6,
+			parts:       6,
+		},
+		"ring of 10 clusters": {
+			clusters:    10,
+			clusterSize: 10,
+			parts:       10,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+
+			// The vertices of the clusters are interleaved, so that their
+			// order doesn't reveal the clusters.
+			vertex := func(cluster, i int) int {
+				return i*test.clusters + cluster
+			}
+
+			for c := 0; c < test.clusters; c++ {
+				members := make([]int, test.clusterSize)
+				for i := range members {
+					members[i] = vertex(c, i)
+					_ = g.AddVertex(members[i])
+				}
+
+				for _, edge := range cliqueEdges(members) {
+					if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+						t.Fatalf("failed to add edge: %s", err.Error())
+					}
+				}
+			}
+
+			for c := 0; c < test.clusters; c++ {
+				next := (c + 1) % test.clusters
+				if err := g.AddEdge(vertex(c, 0), vertex(next, 1)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			parts, err := Partition(g, test.parts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			order, _ := g.Order()
+			if len(parts) != order {
+				t.Fatalf("expected %d assigned vertices, got %d", order, len(parts))
+			}
+
+			clusterParts := make(map[int]struct{})
+
+			for c := 0; c < test.clusters; c++ {
+				part := parts[vertex(c, 0)]
+
+				if part < 0 || part >= test.parts {
+					t.Fatalf("expected part between 0 and %d, got %d", test.parts-1, part)
+				}
+
+				for i := 1; i < test.clusterSize; i++ {
+					if parts[vertex(c, i)] != part {
+						t.Errorf("expected cluster %d to be assigned to part %d, got %v", c, part, parts)
+					}
+				}
+
+				clusterParts[part] = struct{}{}
+			}
+
+			if len(clusterParts) != test.clusters {
+				t.Errorf("expected each cluster to be assigned to its own part, got %v", parts)
+			}
+		})
+	}
+}
+
+func TestPartition_invalidParts(t *testing.T) {
+	g := New(IntHash)
+	_ = g.AddVertex(1)
+
+	if _, err := Partition(g, 0); err == nil {
+		t.Errorf("expected error for 0 parts")
+	}
+
+	parts, err := Partition(g, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if parts[1] < 0 || parts[1] > 2 {
+		t.Errorf("expected part between 0 and 2, got %d", parts[1])
+	}
+}
+
+func TestGlobalMinCut(t *testing.T) {
+	tests := map[string]struct {
+		traits         []func(*Traits)
+		vertices       []int
+		edges          []Edge[int]
+		weight         func(Edge[int]) float64
+		expectedSide   []int
+		expectedWeight float64
+		shouldFail     bool
+	}{
+		"graph from the Stoer-Wagner paper": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2, 3, 4, 5, 6, 7, 8},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 2}},
+				{Source: 1, Target: 5, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 5, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 6, Properties: EdgeProperties{Weight: 2}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 4}},
+				{Source: 3, Target: 7, Properties: EdgeProperties{Weight: 2}},
+				{Source: 4, Target: 7, Properties: EdgeProperties{Weight: 2}},
+				{Source: 4, Target: 8, Properties: EdgeProperties{Weight: 2}},
+				{Source: 5, Target: 6, Properties: EdgeProperties{Weight: 3}},
+				{Source: 6, Target: 7, Properties: EdgeProperties{Weight: 1}},
+				{Source: 7, Target: 8, Properties: EdgeProperties{Weight: 3}},
+			},
+			expectedSide:   []int{3, 4, 7, 8},
+			expectedWeight: 4,
+		},
+		"two cliques joined by a bridge": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: append(append(
+				cliqueEdges([]int{1, 2, 3}),
+				cliqueEdges([]int{4, 5, 6})...),
+				Edge[int]{Source: 3, Target: 4},
+			),
+			expectedSide:   []int{1, 2, 3},
+			expectedWeight: 1,
+		},
+		"custom weight function": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Data: 5.0}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Data: 0.5}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Data: 4.0}},
+			},
+			weight: func(edge Edge[int]) float64 {
+				return edge.Properties.Data.(float64)
+			},
+			expectedSide:   []int{3},
+			expectedWeight: 4.5,
+		},
+		"disconnected graph": {
+			traits:         []func(*Traits){},
+			vertices:       []int{1, 2, 3},
+			edges:          []Edge[int]{{Source: 1, Target: 2}},
+			expectedSide:   []int{3},
+			expectedWeight: 0,
+		},
+		"directed graph": {
+			traits:     []func(*Traits){Directed()},
+			vertices:   []int{1, 2},
+			shouldFail: true,
+		},
+		"single vertex": {
+			traits:     []func(*Traits){},
+			vertices:   []int{1},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			side, weight, err := GlobalMinCut(g, test.weight)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if weight != test.expectedWeight {
+				t.Errorf("expected cut weight %v, got %v", test.expectedWeight, weight)
+			}
+
+			// The returned side may be either side of the cut.
+			otherSide := make([]int, 0)
+			for _, vertex := range test.vertices {
+				if !containsVertex(test.expectedSide, vertex) {
+					otherSide = append(otherSide, vertex)
+				}
+			}
+
+			if !slicesAreEqual(side, test.expectedSide) && !slicesAreEqual(side, otherSide) {
+				t.Errorf("expected side %v or %v, got %v", test.expectedSide, otherSide, side)
+			}
+		})
+	}
+}
+
+func containsVertex(vertices []int, vertex int) bool {
+	for _, v := range vertices {
+		if v == vertex {
+			return true
+		}
+	}
+	return false
+}
@@ -9,11 +9,19 @@ import (
 
 var ErrTargetNotReachable = errors.New("target vertex not reachable from source")
 
+// ErrNoAlternativePath is returned by [SecondShortestPath] if the shortest path
+// is the only path between the source and the target.
+var ErrNoAlternativePath = errors.New("no alternative path between source and target")
+
 // CreatesCycle determines whether adding an edge between the two given vertices
 // would introduce a cycle in the graph. CreatesCycle will not create an edge.
 //
 // A potential edge would create a cycle if the target vertex is also a parent
 // of the source vertex. In order to determine this, CreatesCycle runs a DFS.
+//
+// If the source or target vertex doesn't exist, an error wrapping
+// ErrVertexNotFound will be returned instead of reporting that no cycle would
+// be created. The same applies to the fast path used by the default store.
 func CreatesCycle[K comparable, T any](g Graph[K, T], source, target K) (bool, error) {
 	if _, err := g.Vertex(source); err != nil {
 		return false, fmt.Errorf("could not get source vertex: %w", err)
@@ -27,7 +35,7 @@ func CreatesCycle[K comparable, T any](g Graph[K, T], source, target K) (bool, e
 		return true, nil
 	}
 
-	predecessorMap, err := g.PredecessorMap()
+	predecessorMap, err := readPredecessorMap(g)
 	if err != nil {
 		return false, fmt.Errorf("failed to get predecessor map: %w", err)
 	}
@@ -66,42 +74,208 @@ func CreatesCycle[K comparable, T any](g Graph[K, T], source, target K) (bool, e
 // not reachable from the source, ErrTargetNotReachable will be returned. Should
 // there be multiple shortest paths, and arbitrary one will be returned.
 //
-// ShortestPath has a time complexity of O(|V|+|E|log(|V|)).
+// ShortestPath uses Dijkstra's algorithm, which has a time complexity of
+// O(|V|+|E|log(|V|)). Only if the graph is directed, weighted, and contains an
+// edge with a negative weight, ShortestPath falls back to the Bellman-Ford
+// algorithm with a time complexity of O(|V|*|E|).
 func ShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, error) {
 	if g.Traits().IsDirected {
-		return bellmanFord(g, source, target, nil)
+		hasNegativeWeights, err := hasNegativeEdgeWeights(g)
+		if err != nil {
+			return nil, err
+		}
+		if hasNegativeWeights {
+			return bellmanFord(g, source, target, nil, edgeWeight[K])
+		}
+	}
+	return dijkstra(g, source, target, dijkstraEdgeWeight(g))
+}
+
+// Distance computes the total weight of the shortest path between a source and
+// a target vertex using the same algorithms as [ShortestPath], but without
+// reconstructing the path. If the target is not reachable from the source,
+// ErrTargetNotReachable will be returned. For unweighted graphs, the distance is
+// the number of edges.
+func Distance[K comparable, T any](g Graph[K, T], source, target K) (float64, error) {
+	var dist map[K]float64
+	var err error
+
+	hasNegativeWeights := false
+	if g.Traits().IsDirected {
+		if hasNegativeWeights, err = hasNegativeEdgeWeights(g); err != nil {
+			return 0, err
+		}
+	}
+
+	if hasNegativeWeights {
+		dist, _, err = bellmanFordDistances(g, source, nil, edgeWeight[K])
+	} else {
+		dist, _, err = dijkstraDistances(g, source, dijkstraEdgeWeight(g))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	distance, ok := dist[target]
+	if !ok || math.IsInf(distance, 1) {
+		return 0, ErrTargetNotReachable
+	}
+
+	return distance, nil
+}
+
+// DijkstraShortestPath computes the shortest path between a source and a target
+// vertex using Dijkstra's algorithm. In contrast to [ShortestPath], it never
+// falls back to Bellman-Ford, so the graph must not contain negative weights.
+func DijkstraShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, error) {
+	return dijkstra(g, source, target, dijkstraEdgeWeight(g))
+}
+
+// DijkstraShortestPathFunc works just like [DijkstraShortestPath], but obtains
+// the weight of each edge using the given weight function instead of reading
+// the edge weight:
+//
+//	path, _ := graph.DijkstraShortestPathFunc(g, "A", "B", func(e graph.Edge[string]) float64 {
+//		return e.Properties.Data.(Road).Length
+//	})
+//
+// The weight function is used regardless of whether the graph is weighted, and
+// it must not return negative weights.
+func DijkstraShortestPathFunc[K comparable, T any](g Graph[K, T], source, target K, weight func(Edge[K]) float64) ([]K, error) {
+	return dijkstra(g, source, target, weight)
+}
+
+// BellmanFordShortestPath computes the shortest path between a source and a
+// target vertex in a directed graph using the Bellman-Ford algorithm. Unlike
+// Dijkstra's algorithm, it supports negative edge weights, but runs in
+// O(|V|*|E|) time. If the graph contains a negative-weight cycle, an error is
+// returned.
+func BellmanFordShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, error) {
+	return bellmanFord(g, source, target, nil, edgeWeight[K])
+}
+
+// BellmanFordShortestPathFunc works just like [BellmanFordShortestPath], but
+// obtains the weight of each edge using the given weight function instead of
+// reading the edge weight. The weight function may return negative weights.
+func BellmanFordShortestPathFunc[K comparable, T any](g Graph[K, T], source, target K, weight func(Edge[K]) float64) ([]K, error) {
+	return bellmanFord(g, source, target, nil, weight)
+}
+
+// edgeWeight is the default weight function that returns the edge weight.
+func edgeWeight[K comparable](edge Edge[K]) float64 {
+	return float64(edge.Properties.Weight)
+}
+
+// dijkstraEdgeWeight returns the default weight function for dijkstra. Setting
+// the weight to 1 is required for unweighted graphs whose edge weights are 0.
+// Otherwise, all paths would have a sum of 0 and a random path would be
+// returned.
+func dijkstraEdgeWeight[K comparable, T any](g Graph[K, T]) func(Edge[K]) float64 {
+	if !g.Traits().IsWeighted {
+		return func(Edge[K]) float64 {
+			return 1
+		}
+	}
+
+	return edgeWeight[K]
+}
+
+// hasNegativeEdgeWeights determines whether the given graph is weighted and has
+// at least one edge with a negative weight. For unweighted graphs, the edge
+// weights are ignored by the shortest path algorithms.
+func hasNegativeEdgeWeights[K comparable, T any](g Graph[K, T]) (bool, error) {
+	if !g.Traits().IsWeighted {
+		return false, nil
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return false, fmt.Errorf("could not get edges: %w", err)
+	}
+
+	for _, edge := range edges {
+		if edge.Properties.Weight < 0 {
+			return true, nil
+		}
 	}
-	return dijkstra(g, source, target)
+
+	return false, nil
 }
 
 func ShortestPathStable[K comparable, T any](g Graph[K, T], source, target K, less func(a, b K) bool) ([]K, error) {
 	if g.Traits().IsDirected {
-		return bellmanFord(g, source, target, less)
+		return bellmanFord(g, source, target, less, edgeWeight[K])
 	}
 	return nil, errors.New("ShortestPathStable only currently supported for directed graphs")
 }
 
-func dijkstra[K comparable, T any](g Graph[K, T], source, target K) ([]K, error) {
-	weights := make(map[K]float64)
-	visited := make(map[K]bool)
+func dijkstra[K comparable, T any](g Graph[K, T], source, target K, edgeWeight func(Edge[K]) float64) ([]K, error) {
+	_, bestPredecessors, err := dijkstraDistances(g, source, edgeWeight)
+	if err != nil {
+		return nil, err
+	}
 
-	weights[source] = 0
-	visited[target] = true
+	path := []K{target}
+	current := target
 
-	queue := newPriorityQueue[K]()
+	for current != source {
+		// If the current vertex is not present in bestPredecessors, current is
+		// set to the zero value of K. Without this check, this would lead to an
+		// endless prepending of zero values to the path. Also, the target would
+		// not be reachable from one of the preceding vertices.
+		if _, ok := bestPredecessors[current]; !ok {
+			return nil, ErrTargetNotReachable
+		}
+		current = bestPredecessors[current]
+		path = append([]K{current}, path...)
+	}
+
+	return path, nil
+}
+
+// dijkstraDistances computes the distances of all vertices reachable from the
+// source using Dijkstra's algorithm. It returns these distances along with the
+// cheapest predecessor of each reachable vertex except for the source.
+// Vertices that aren't reachable are not contained in the returned maps.
+func dijkstraDistances[K comparable, T any](g Graph[K, T], source K, edgeWeight func(Edge[K]) float64) (map[K]float64, map[K]K, error) {
+	// If the graph is able to compute the distances itself, use that fast path.
+	if sp, ok := g.(interface {
+		shortestPaths(source K, edgeWeight func(Edge[K]) float64) (map[K]float64, map[K]K, error)
+	}); ok {
+		return sp.shortestPaths(source, edgeWeight)
+	}
+
+	return adjacencyMapDijkstra(g, source, edgeWeight)
+}
+
+// adjacencyMapDijkstra is the slow path of dijkstraDistances that works with
+// any graph implementation by obtaining its adjacency map.
+func adjacencyMapDijkstra[K comparable, T any](g Graph[K, T], source K, edgeWeight func(Edge[K]) float64) (map[K]float64, map[K]K, error) {
 	adjacencyMap, err := g.AdjacencyMap()
 	if err != nil {
-		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+		return nil, nil, fmt.Errorf("could not get adjacency map: %w", err)
 	}
 
-	for hash := range adjacencyMap {
-		if hash != source {
-			weights[hash] = math.Inf(1)
-			visited[hash] = false
-		}
+	weights, bestPredecessors := runDijkstra(source, func(vertex K) map[K]Edge[K] {
+		return adjacencyMap[vertex]
+	}, edgeWeight, nil)
 
-		queue.Push(hash, weights[hash])
-	}
+	return weights, bestPredecessors, nil
+}
+
+// runDijkstra runs Dijkstra's algorithm from the source vertex, obtaining the
+// outgoing edges of each vertex using the given adjacencies function. It only
+// visits vertices that are reachable from the source.
+//
+// If finalize is not nil, it is invoked for each vertex once its weight has
+// been determined, along with its cheapest predecessor, which is the zero value
+// for the source. If finalize returns false, the search stops.
+func runDijkstra[K comparable](source K, adjacencies func(vertex K) map[K]Edge[K], edgeWeight func(Edge[K]) float64, finalize func(vertex, predecessor K, weight float64) bool) (map[K]float64, map[K]K) {
+	weights := map[K]float64{source: 0}
+	finalized := make(map[K]struct{})
+
+	queue := newPriorityQueue[K]()
+	queue.Push(source, 0)
 
 	// bestPredecessors stores the cheapest or least-weighted predecessor for
 	// each vertex. Given an edge AC with weight=4 and an edge BC with weight=2,
@@ -110,44 +284,117 @@ func dijkstra[K comparable, T any](g Graph[K, T], source, target K) ([]K, error)
 
 	for queue.Len() > 0 {
 		vertex, _ := queue.Pop()
-		hasInfiniteWeight := math.IsInf(weights[vertex], 1)
+		finalized[vertex] = struct{}{}
 
-		for adjacency, edge := range adjacencyMap[vertex] {
-			edgeWeight := edge.Properties.Weight
+		if finalize != nil && !finalize(vertex, bestPredecessors[vertex], weights[vertex]) {
+			break
+		}
 
-			// Setting the weight to 1 is required for unweighted graphs whose
-			// edge weights are 0. Otherwise, all paths would have a sum of 0
-			// and a random path would be returned.
-			if !g.Traits().IsWeighted {
-				edgeWeight = 1
+		for adjacency, edge := range adjacencies(vertex) {
+			// The weight of a finalized vertex has already been determined and
+			// the vertex has been removed from the queue, so it must not be
+			// updated anymore.
+			if _, ok := finalized[adjacency]; ok {
+				continue
 			}
 
-			weight := weights[vertex] + float64(edgeWeight)
+			weight := weights[vertex] + edgeWeight(edge)
 
-			if weight < weights[adjacency] && !hasInfiniteWeight {
+			// A vertex is only pushed to the queue once it has been discovered.
+			// If it already is in the queue, Push does nothing and its priority
+			// is updated instead.
+			if current, ok := weights[adjacency]; !ok || weight < current {
 				weights[adjacency] = weight
 				bestPredecessors[adjacency] = vertex
+				queue.Push(adjacency, weight)
 				queue.UpdatePriority(adjacency, weight)
 			}
 		}
 	}
 
-	path := []K{target}
-	current := target
+	return weights, bestPredecessors
+}
 
-	for current != source {
-		// If the current vertex is not present in bestPredecessors, current is
-		// set to the zero value of K. Without this check, this would lead to an
-		// endless prepending of zero values to the path. Also, the target would
-		// not be reachable from one of the preceding vertices.
-		if _, ok := bestPredecessors[current]; !ok {
-			return nil, ErrTargetNotReachable
+// DijkstraStream returns an iterator function that yields all vertices reachable
+// from the source nearest-first, in the order in which Dijkstra's algorithm
+// finalizes them. Each vertex is yielded with its distance and a function that
+// builds its shortest path on demand. The search stops once yield returns false.
+//
+// If the source vertex doesn't exist, yield is called once with a non-nil error.
+// For unweighted graphs, each edge has a weight of 1. Negative edge weights are
+// not supported.
+func DijkstraStream[K comparable, T any](g Graph[K, T], source K) func(yield func(vertex K, dist float64, path func() []K, err error) bool) {
+	return func(yield func(vertex K, dist float64, path func() []K, err error) bool) {
+		var empty K
+
+		adjacencyMap, err := readAdjacencyMap(g)
+		if err != nil {
+			yield(empty, 0, nil, fmt.Errorf("could not get adjacency map: %w", err))
+			return
 		}
-		current = bestPredecessors[current]
-		path = append([]K{current}, path...)
+
+		if _, ok := adjacencyMap[source]; !ok {
+			yield(empty, 0, nil, &VertexNotFoundError[K]{Key: source})
+			return
+		}
+
+		// The predecessor of a finalized vertex doesn't change anymore, so the
+		// path of each yielded vertex only consists of finalized vertices.
+		predecessors := make(map[K]K)
+
+		runDijkstra(source, func(vertex K) map[K]Edge[K] {
+			return adjacencyMap[vertex]
+		}, dijkstraEdgeWeight(g), func(vertex, predecessor K, weight float64) bool {
+			if vertex != source {
+				predecessors[vertex] = predecessor
+			}
+
+			path := func() []K {
+				path := []K{vertex}
+				for current := vertex; current != source; {
+					current = predecessors[current]
+					path = append(path, current)
+				}
+				for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+					path[i], path[j] = path[j], path[i]
+				}
+				return path
+			}
+
+			return yield(vertex, weight, path, nil)
+		})
 	}
+}
 
-	return path, nil
+// DijkstraWithTrace runs Dijkstra's algorithm from the source vertex and returns
+// its entire search tree: the cheapest predecessor of each reachable vertex
+// except for the source, the distance of each reachable vertex, and the order
+// in which the vertices have been finalized, i.e. nearest-first.
+//
+// Vertices that aren't reachable from the source are not contained in any of
+// the return values. For unweighted graphs, each edge has a weight of 1. Just
+// as [DijkstraShortestPath], DijkstraWithTrace doesn't support negative edge
+// weights.
+func DijkstraWithTrace[K comparable, T any](g Graph[K, T], source K) (map[K]K, map[K]float64, []K, error) {
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, nil, nil, &VertexNotFoundError[K]{Key: source}
+	}
+
+	order := make([]K, 0, len(adjacencyMap))
+
+	distances, predecessors := runDijkstra(source, func(vertex K) map[K]Edge[K] {
+		return adjacencyMap[vertex]
+	}, dijkstraEdgeWeight(g), func(vertex, _ K, _ float64) bool {
+		order = append(order, vertex)
+		return true
+	})
+
+	return predecessors, distances, order, nil
 }
 
 // bellmanFord is a helper function for ShortestPath that uses the Bellman-Ford algorithm to
@@ -156,22 +403,46 @@ func dijkstra[K comparable, T any](g Graph[K, T], source, target K) ([]K, error)
 //
 // The returned path includes the source and target vertices. If the target cannot be reached
 // from the source vertex, ErrTargetNotReachable will be returned. If there are multiple shortest
-func bellmanFord[K comparable, T any](g Graph[K, T], source, target K, less func(a, b K) bool) ([]K, error) {
+func bellmanFord[K comparable, T any](g Graph[K, T], source, target K, less func(a, b K) bool, edgeWeight func(Edge[K]) float64) ([]K, error) {
+	_, prev, err := bellmanFordDistances(g, source, less, edgeWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	path := []K{}
+	u := target
+	for u != source {
+		if _, ok := prev[u]; !ok {
+			return nil, ErrTargetNotReachable
+		}
+		path = append([]K{u}, path...)
+		u = prev[u]
+	}
+	path = append([]K{source}, path...)
+	return path, nil
+}
 
+// bellmanFordDistances computes the distances of all vertices from the source
+// using the Bellman-Ford algorithm. It returns these distances, where
+// unreachable vertices have an infinite distance, along with the predecessor of
+// each reachable vertex except for the source. If the graph contains a
+// negative-weight cycle reachable from the source, a NegativeCycleError will be
+// returned.
+func bellmanFordDistances[K comparable, T any](g Graph[K, T], source K, less func(a, b K) bool, edgeWeight func(Edge[K]) float64) (map[K]float64, map[K]K, error) {
 	if !g.Traits().IsDirected {
-		return nil, errors.New("Bellman-Ford algorithm can only be used on directed graphs")
+		return nil, nil, errors.New("Bellman-Ford algorithm can only be used on directed graphs")
 	}
 
-	dist := make(map[K]int)
+	dist := make(map[K]float64)
 	prev := make(map[K]K)
 
 	adjacencyMap, err := g.AdjacencyMap()
 	if err != nil {
-		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+		return nil, nil, fmt.Errorf("could not get adjacency map: %w", err)
 	}
 	keys := make([]K, 0, len(adjacencyMap))
 	for key := range adjacencyMap {
-		dist[key] = math.MaxInt32
+		dist[key] = math.Inf(1)
 		keys = append(keys, key)
 	}
 	dist[source] = 0
@@ -183,9 +454,14 @@ func bellmanFord[K comparable, T any](g Graph[K, T], source, target K, less func
 
 	for i := 0; i < len(adjacencyMap)-1; i++ {
 		for _, key := range keys {
+			// Vertices that haven't been reached yet can't be used to relax
+			// their edges, since their distance is still infinite.
+			if math.IsInf(dist[key], 1) {
+				continue
+			}
 			edges := adjacencyMap[key]
 			for _, edge := range edges {
-				if newDist := dist[key] + edge.Properties.Weight; newDist < dist[edge.Target] {
+				if newDist := dist[key] + edgeWeight(edge); newDist < dist[edge.Target] {
 					dist[edge.Target] = newDist
 					prev[edge.Target] = key
 				}
@@ -195,23 +471,250 @@ func bellmanFord[K comparable, T any](g Graph[K, T], source, target K, less func
 
 	for _, edges := range adjacencyMap {
 		for _, edge := range edges {
-			if newDist := dist[edge.Source] + edge.Properties.Weight; newDist < dist[edge.Target] {
-				return nil, errors.New("graph contains a negative-weight cycle")
+			if math.IsInf(dist[edge.Source], 1) {
+				continue
+			}
+			if newDist := dist[edge.Source] + edgeWeight(edge); newDist < dist[edge.Target] {
+				prev[edge.Target] = edge.Source
+				return nil, nil, &NegativeCycleError[K]{Cycle: negativeCycle(prev, edge.Target, len(adjacencyMap))}
 			}
 		}
 	}
 
-	path := []K{}
-	u := target
-	for u != source {
-		if _, ok := prev[u]; !ok {
-			return nil, ErrTargetNotReachable
+	return dist, prev, nil
+}
+
+// ShortestPathWithHopLimit computes the path with the smallest total weight
+// between a source and a target vertex that consists of at most maxHops edges,
+// along with its weight. If there is no such path, ErrTargetNotReachable will be
+// returned. For unweighted graphs, each edge has a weight of 1.
+//
+// ShortestPathWithHopLimit runs maxHops rounds of the Bellman-Ford algorithm in
+// O(maxHops*(|V|+|E|)) time. Negative edge weights are supported, and since the
+// number of hops is limited, the path may pass through a negative cycle.
+func ShortestPathWithHopLimit[K comparable, T any](g Graph[K, T], source, target K, maxHops int) ([]K, float64, error) {
+	if maxHops < 0 {
+		return nil, 0, fmt.Errorf("hop limit must not be negative, got %d", maxHops)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, 0, &VertexNotFoundError[K]{Key: source}
+	}
+
+	if _, ok := adjacencyMap[target]; !ok {
+		return nil, 0, &VertexNotFoundError[K]{Key: target}
+	}
+
+	weight := dijkstraEdgeWeight(g)
+	dist := map[K]float64{source: 0}
+
+	// predecessors contains the predecessors of all vertices whose distance
+	// has been improved in the respective round.
+	predecessors := make([]map[K]K, 0, maxHops)
+
+	for hop := 0; hop < maxHops; hop++ {
+		next := make(map[K]float64, len(dist))
+		for vertex, distance := range dist {
+			next[vertex] = distance
 		}
-		path = append([]K{u}, path...)
-		u = prev[u]
+
+		improved := make(map[K]K)
+
+		for vertex, distance := range dist {
+			for adjacency, edge := range adjacencyMap[vertex] {
+				newDist := distance + weight(edge)
+				if current, ok := next[adjacency]; !ok || newDist < current {
+					next[adjacency] = newDist
+					improved[adjacency] = vertex
+				}
+			}
+		}
+
+		// If no distance has been improved, further rounds won't change them
+		// either.
+		if len(improved) == 0 {
+			break
+		}
+
+		dist = next
+		predecessors = append(predecessors, improved)
 	}
-	path = append([]K{source}, path...)
-	return path, nil
+
+	distance, ok := dist[target]
+	if !ok {
+		return nil, 0, ErrTargetNotReachable
+	}
+
+	path := []K{target}
+	current := target
+
+	for hop := len(predecessors) - 1; hop >= 0; hop-- {
+		if predecessor, ok := predecessors[hop][current]; ok {
+			current = predecessor
+			path = append([]K{current}, path...)
+		}
+	}
+
+	return path, distance, nil
+}
+
+// FindNegativeCycle searches the entire graph for a cycle whose total weight is
+// negative and returns it along with true, or false if there is none. The cycle
+// is in edge direction and ends with its first vertex, just as the cycle of a
+// [NegativeCycleError]. For unweighted graphs, each edge has a weight of 1.
+//
+// FindNegativeCycle uses the Bellman-Ford algorithm and runs in O(|V|*|E|) time.
+func FindNegativeCycle[K comparable, T any](g Graph[K, T]) ([]K, bool, error) {
+	if !g.Traits().IsDirected {
+		return nil, false, errors.New("negative cycles can only be found in directed graphs")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, false, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	weight := dijkstraEdgeWeight(g)
+	dist := make(map[K]float64, len(adjacencyMap))
+	prev := make(map[K]K)
+
+	for key := range adjacencyMap {
+		dist[key] = 0
+	}
+
+	for i := 0; i < len(adjacencyMap); i++ {
+		relaxed := false
+		var lastRelaxed K
+
+		for _, edges := range adjacencyMap {
+			for _, edge := range edges {
+				if newDist := dist[edge.Source] + weight(edge); newDist < dist[edge.Target] {
+					dist[edge.Target] = newDist
+					prev[edge.Target] = edge.Source
+					relaxed = true
+					lastRelaxed = edge.Target
+				}
+			}
+		}
+
+		// If no distance has changed, the distances are final and there can't
+		// be a negative cycle.
+		if !relaxed {
+			return nil, false, nil
+		}
+
+		if i == len(adjacencyMap)-1 {
+			return negativeCycle(prev, lastRelaxed, len(adjacencyMap)), true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// PathWeight computes the total weight of the given path, which is the sum of
+// the weights of all edges along the path, including the edge leading to the
+// last vertex. The weights of the vertices themselves are not taken into
+// account. A path consisting of a single vertex has a weight of 0.
+//
+// If two consecutive vertices in the path aren't joined by an edge, an error
+// wrapping ErrEdgeNotFound will be returned.
+func PathWeight[K comparable, T any](g Graph[K, T], path []K) (int, error) {
+	weight := 0
+
+	for i := 1; i < len(path); i++ {
+		edge, err := g.Edge(path[i-1], path[i])
+		if err != nil {
+			return 0, fmt.Errorf("failed to get edge (%v, %v): %w", path[i-1], path[i], err)
+		}
+		weight += edge.Properties.Weight
+	}
+
+	return weight, nil
+}
+
+// PathEdges returns the edges along the given path, where the i-th edge joins
+// the i-th and the (i+1)-th vertex of the path. Each edge points in the
+// direction of the path, even in undirected graphs. If two consecutive vertices
+// aren't joined by an edge, an error wrapping ErrEdgeNotFound will be returned.
+func PathEdges[K comparable, T any](g Graph[K, T], path []K) ([]Edge[K], error) {
+	edges := make([]Edge[K], 0, len(path))
+
+	for i := 1; i < len(path); i++ {
+		edge, err := g.Edge(path[i-1], path[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get edge (%v, %v): %w", path[i-1], path[i], err)
+		}
+		edges = append(edges, Edge[K]{
+			Source:     path[i-1],
+			Target:     path[i],
+			Properties: edge.Properties,
+		})
+	}
+
+	return edges, nil
+}
+
+// ReversePath returns a new path containing the vertices of the given path in
+// reverse order. The given path isn't modified.
+func ReversePath[K comparable](path []K) []K {
+	reversed := make([]K, len(path))
+
+	for i, vertex := range path {
+		reversed[len(path)-1-i] = vertex
+	}
+
+	return reversed
+}
+
+// AppendPath joins two paths, where the last vertex of the first path must be
+// the first vertex of the second path. The shared vertex occurs only once in the
+// returned path. If either path is empty, a copy of the other path is returned.
+// Neither of the given paths is modified.
+func AppendPath[K comparable](path, other []K) ([]K, error) {
+	if len(path) > 0 && len(other) > 0 && path[len(path)-1] != other[0] {
+		return nil, fmt.Errorf("path ends with %v, but the appended path starts with %v", path[len(path)-1], other[0])
+	}
+
+	joined := make([]K, 0, len(path)+len(other))
+	joined = append(joined, path...)
+
+	if len(path) > 0 && len(other) > 0 {
+		other = other[1:]
+	}
+
+	return append(joined, other...), nil
+}
+
+// negativeCycle reconstructs a negative-weight cycle from the predecessor map
+// computed by Bellman-Ford, starting at a vertex whose distance could still be
+// relaxed after |V|-1 iterations. Walking the predecessors of such a vertex |V|
+// times is guaranteed to end up on the cycle, which is then traversed once.
+//
+// The returned cycle is in edge direction and ends with its first vertex.
+func negativeCycle[K comparable](prev map[K]K, start K, order int) []K {
+	current := start
+
+	for i := 0; i < order; i++ {
+		current = prev[current]
+	}
+
+	cycle := []K{current}
+
+	for vertex := prev[current]; vertex != current; vertex = prev[vertex] {
+		cycle = append(cycle, vertex)
+	}
+	cycle = append(cycle, current)
+
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+
+	return cycle
 }
 
 type sccState[K comparable] struct {
@@ -234,7 +737,7 @@ func StronglyConnectedComponents[K comparable, T any](g Graph[K, T]) ([][]K, err
 		return nil, errors.New("SCCs can only be detected in directed graphs")
 	}
 
-	adjacencyMap, err := g.AdjacencyMap()
+	adjacencyMap, err := readAdjacencyMap(g)
 	if err != nil {
 		return nil, fmt.Errorf("could not get adjacency map: %w", err)
 	}
@@ -306,18 +809,124 @@ func findSCC[K comparable](vertexHash K, state *sccState[K]) {
 	}
 }
 
+// SCCQuotient writes the quotient graph of g by its strongly connected
+// components into the given out graph, which should be a new, empty directed
+// graph, e.g. created using [NewLike]. Each component is represented by the
+// vertex chosen by the representative function, or by an arbitrary vertex if
+// representative is nil. Edges joining two components are merged into a single
+// edge with the properties of the lightest one, and edges within a component are
+// dropped. The returned map maps each vertex in g to its representative.
+func SCCQuotient[K comparable, T any](g Graph[K, T], representative func(component []K) K, out Graph[K, T]) (map[K]K, error) {
+	components, err := StronglyConnectedComponents(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get strongly connected components: %w", err)
+	}
+
+	if representative == nil {
+		representative = func(component []K) K {
+			return component[0]
+		}
+	}
+
+	representatives := make(map[K]K)
+
+	for _, component := range components {
+		r := representative(component)
+		isMember := false
+
+		for _, vertex := range component {
+			representatives[vertex] = r
+			if vertex == r {
+				isMember = true
+			}
+		}
+
+		if !isMember {
+			return nil, fmt.Errorf("representative %v is not part of its component", r)
+		}
+
+		vertex, properties, err := g.VertexWithProperties(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", r, err)
+		}
+
+		if err := out.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", r, err)
+		}
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	quotientEdges := make(map[EdgeKey[K]]Edge[K])
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			key := EdgeKey[K]{Source: representatives[source], Target: representatives[target]}
+			if key.Source == key.Target {
+				continue
+			}
+
+			if existing, ok := quotientEdges[key]; ok && existing.Properties.Weight <= edge.Properties.Weight {
+				continue
+			}
+
+			quotientEdges[key] = Edge[K]{
+				Source:     key.Source,
+				Target:     key.Target,
+				Properties: edge.Properties,
+			}
+		}
+	}
+
+	for key, edge := range quotientEdges {
+		if err := out.AddEdge(copyEdge(edge)); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", key.Source, key.Target, err)
+		}
+	}
+
+	return representatives, nil
+}
+
 // AllPathsBetween computes and returns all paths between two given vertices. A
 // path is represented as a slice of vertex hashes. The returned slice contains
 // these paths.
 //
+// Each vertex occurs at most once in a path. If the start and the end vertex
+// are the same, the paths are the cycles through that vertex: It occurs at the
+// beginning and at the end of each path, but nowhere in between, and a
+// self-loop yields a path consisting of the vertex twice. In undirected graphs,
+// walking an edge back and forth also counts as such a cycle.
+//
 // AllPathsBetween utilizes a non-recursive, stack-based implementation. It has
 // an estimated runtime complexity of O(n^2) where n is the number of vertices.
+// To process the paths one at a time instead, use [WalkPathsBetween].
 func AllPathsBetween[K comparable, T any](g Graph[K, T], start, end K) ([][]K, error) {
-	adjacencyMap, err := g.AdjacencyMap()
+	allPaths := make([][]K, 0)
+
+	err := WalkPathsBetween(g, start, end, func(path []K) bool {
+		allPaths = append(allPaths, path)
+		return false
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return allPaths, nil
+}
+
+// WalkPathsBetween enumerates all paths between two given vertices, just like
+// [AllPathsBetween], but passes each path to the visit function as soon as it
+// has been found. If the visit function returns true, the enumeration will be
+// stopped. The path passed to the visit function may be retained by the caller.
+func WalkPathsBetween[K comparable, T any](g Graph[K, T], start, end K, visit func(path []K) bool) error {
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return err
+	}
+
 	// Use a pool to save on allocations
 	var oldStacks []*stack[K]
 	newStack := func() *stack[K] {
@@ -346,16 +955,19 @@ func AllPathsBetween[K comparable, T any](g Graph[K, T], start, end K) ([][]K, e
 		mainStack.push(element)
 		newElements := newStack()
 
+		// A path is complete once it reaches the end vertex, so the end vertex
+		// is only expanded if it is the start vertex of the path.
+		if element == end && len(mainStack.elements) > 1 {
+			viceStack.push(newElements)
+			return
+		}
+
 		for e := range adjacencyMap[element] {
-			var contains bool
-			var containsCount int
-			mainStack.forEach(func(k K) {
-				if e == k {
-					contains = true
-					containsCount++
-				}
-			})
-			if contains && (e != start || e != end) || containsCount > 1 {
+			// A vertex that is already part of the path must not be visited
+			// again. The only exception is the start vertex if it also is the
+			// end vertex: Revisiting it closes the cycle and completes the path,
+			// so it is revisited at most once.
+			if mainStack.contains(e) && (e != start || start != end) {
 				continue
 			}
 			newElements.push(e)
@@ -397,31 +1009,490 @@ func AllPathsBetween[K comparable, T any](g Graph[K, T], start, end K) ([][]K, e
 
 	buildLayer(start)
 
-	allPaths := make([][]K, 0)
-
 	for !mainStack.isEmpty() {
 		v, _ := mainStack.top()
 		adjs, _ := viceStack.top()
 
 		if adjs.isEmpty() {
 			if v == end && len(mainStack.elements) > 1 {
-				path := make([]K, 0)
+				path := make([]K, 0, len(mainStack.elements))
 				mainStack.forEach(func(k K) {
 					path = append(path, k)
 				})
-				allPaths = append(allPaths, path)
+				if stop := visit(path); stop {
+					return nil
+				}
 			}
 
 			err = removeLayer()
 			if err != nil {
-				return nil, err
+				return err
 			}
 		} else {
 			if err = buildStack(); err != nil {
-				return nil, err
+				return err
 			}
 		}
 	}
 
-	return allPaths, nil
+	return nil
+}
+
+// KShortestPathsAvoiding computes up to k loopless paths between a source and a
+// target vertex that don't pass through any of the avoided vertices, ordered by
+// their total weight. If there is no such path, ErrTargetNotReachable will be
+// returned. For unweighted graphs, each edge has a weight of 1.
+//
+// KShortestPathsAvoiding uses Yen's algorithm and doesn't support negative edge
+// weights.
+func KShortestPathsAvoiding[K comparable, T any](g Graph[K, T], source, target K, k int, avoid map[K]struct{}) ([][]K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, &VertexNotFoundError[K]{Key: source}
+	}
+
+	if _, ok := adjacencyMap[target]; !ok {
+		return nil, &VertexNotFoundError[K]{Key: target}
+	}
+
+	if k < 1 {
+		return [][]K{}, nil
+	}
+
+	search := yenSearch[K]{
+		adjacencyMap: adjacencyMap,
+		isDirected:   g.Traits().IsDirected,
+		weight:       dijkstraEdgeWeight(g),
+	}
+
+	path, ok := search.shortestPath(source, target, avoid, nil)
+	if !ok {
+		return nil, ErrTargetNotReachable
+	}
+
+	paths := [][]K{path}
+	candidates := make([]weightedPath[K], 0)
+
+	for len(paths) < k {
+		previous := paths[len(paths)-1]
+
+		// Each vertex of the previous path except for the target serves as a
+		// spur vertex, where a new path deviates from the previous one.
+		for i := 0; i < len(previous)-1; i++ {
+			rootPath := previous[:i+1]
+
+			// The edges leaving the root path towards the vertices that have
+			// already been used by the known paths with the same root path are
+			// removed, so that the spur path is a different one.
+			removedEdges := make(map[EdgeKey[K]]struct{})
+			for _, path := range paths {
+				if len(path) > i+1 && pathsAreEqual(path[:i+1], rootPath) {
+					removedEdges[EdgeKey[K]{Source: path[i], Target: path[i+1]}] = struct{}{}
+				}
+			}
+
+			// The vertices of the root path are avoided so that the combined
+			// path remains loopless.
+			removedVertices := make(map[K]struct{}, len(avoid)+i)
+			for vertex := range avoid {
+				removedVertices[vertex] = struct{}{}
+			}
+			for _, vertex := range rootPath[:i] {
+				removedVertices[vertex] = struct{}{}
+			}
+
+			spurPath, ok := search.shortestPath(previous[i], target, removedVertices, removedEdges)
+			if !ok {
+				continue
+			}
+
+			candidate := make([]K, 0, i+len(spurPath))
+			candidate = append(candidate, rootPath[:i]...)
+			candidate = append(candidate, spurPath...)
+
+			if containsPath(paths, candidate) || containsWeightedPath(candidates, candidate) {
+				continue
+			}
+
+			candidates = append(candidates, weightedPath[K]{
+				path:   candidate,
+				weight: search.pathWeight(candidate),
+			})
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		// The candidates are sorted by weight and, for equal weights, by their
+		// number of vertices. The sort is stable to keep the order deterministic
+		// for candidates that are equal in both regards.
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].weight != candidates[j].weight {
+				return candidates[i].weight < candidates[j].weight
+			}
+			return len(candidates[i].path) < len(candidates[j].path)
+		})
+
+		paths = append(paths, candidates[0].path)
+		candidates = candidates[1:]
+	}
+
+	return paths, nil
+}
+
+// SecondShortestPath computes the shortest loopless path between a source and a
+// target vertex that differs from the shortest path by at least one edge, along
+// with its weight. If the target is not reachable, ErrTargetNotReachable will be
+// returned, and if there is no other path, ErrNoAlternativePath will be returned.
+// For unweighted graphs, each edge has a weight of 1. Negative edge weights are
+// not supported.
+func SecondShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, 0, &VertexNotFoundError[K]{Key: source}
+	}
+
+	if _, ok := adjacencyMap[target]; !ok {
+		return nil, 0, &VertexNotFoundError[K]{Key: target}
+	}
+
+	search := yenSearch[K]{
+		adjacencyMap: adjacencyMap,
+		isDirected:   g.Traits().IsDirected,
+		weight:       dijkstraEdgeWeight(g),
+	}
+
+	shortestPath, ok := search.shortestPath(source, target, nil, nil)
+	if !ok {
+		return nil, 0, ErrTargetNotReachable
+	}
+
+	var best []K
+	bestWeight := math.Inf(1)
+
+	for i := 1; i < len(shortestPath); i++ {
+		removedEdges := map[EdgeKey[K]]struct{}{
+			{Source: shortestPath[i-1], Target: shortestPath[i]}: {},
+		}
+
+		path, ok := search.shortestPath(source, target, nil, removedEdges)
+		if !ok {
+			continue
+		}
+
+		if weight := search.pathWeight(path); weight < bestWeight {
+			best, bestWeight = path, weight
+		}
+	}
+
+	if best == nil {
+		return nil, 0, ErrNoAlternativePath
+	}
+
+	return best, bestWeight, nil
+}
+
+// AllShortestPaths computes all paths between a source and a target vertex that
+// have the minimum total weight, in no particular order. If the target is not
+// reachable from the source, ErrTargetNotReachable will be returned. For
+// unweighted graphs, each edge has a weight of 1. Negative edge weights are not
+// supported.
+func AllShortestPaths[K comparable, T any](g Graph[K, T], source, target K) ([][]K, error) {
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get predecessor map: %w", err)
+	}
+
+	if _, ok := predecessorMap[source]; !ok {
+		return nil, &VertexNotFoundError[K]{Key: source}
+	}
+
+	if _, ok := predecessorMap[target]; !ok {
+		return nil, &VertexNotFoundError[K]{Key: target}
+	}
+
+	weight := dijkstraEdgeWeight(g)
+
+	dist, _, err := dijkstraDistances(g, source, weight)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := dist[target]; !ok {
+		return nil, ErrTargetNotReachable
+	}
+
+	paths := make([][]K, 0)
+
+	// reversedPath holds the vertices from the target to the current vertex.
+	// Since edges with a weight of 0 may form cycles between vertices with the
+	// same distance, vertices already contained in the path are skipped.
+	reversedPath := []K{target}
+	onPath := map[K]struct{}{target: {}}
+
+	var walk func(vertex K)
+	walk = func(vertex K) {
+		if vertex == source {
+			path := make([]K, len(reversedPath))
+			for i, v := range reversedPath {
+				path[len(reversedPath)-1-i] = v
+			}
+			paths = append(paths, path)
+			return
+		}
+
+		for predecessor, edge := range predecessorMap[vertex] {
+			if _, ok := onPath[predecessor]; ok {
+				continue
+			}
+
+			predecessorDist, ok := dist[predecessor]
+			if !ok || predecessorDist+weight(edge) != dist[vertex] {
+				continue
+			}
+
+			reversedPath = append(reversedPath, predecessor)
+			onPath[predecessor] = struct{}{}
+
+			walk(predecessor)
+
+			reversedPath = reversedPath[:len(reversedPath)-1]
+			delete(onPath, predecessor)
+		}
+	}
+
+	walk(target)
+
+	return paths, nil
+}
+
+// weightedPath is a path along with its total weight.
+type weightedPath[K comparable] struct {
+	path   []K
+	weight float64
+}
+
+// yenSearch holds the state shared by all shortest path computations performed
+// by Yen's algorithm.
+type yenSearch[K comparable] struct {
+	adjacencyMap map[K]map[K]Edge[K]
+	isDirected   bool
+	weight       func(Edge[K]) float64
+}
+
+// shortestPath computes the shortest path from source to target using Dijkstra's
+// algorithm while ignoring the given vertices and edges. For undirected graphs,
+// a removed edge is ignored in both directions. The second return value reports
+// whether the target is reachable at all.
+func (y *yenSearch[K]) shortestPath(source, target K, removedVertices map[K]struct{}, removedEdges map[EdgeKey[K]]struct{}) ([]K, bool) {
+	if _, ok := removedVertices[source]; ok {
+		return nil, false
+	}
+
+	weights := map[K]float64{source: 0}
+	predecessors := make(map[K]K)
+	finalized := make(map[K]struct{})
+
+	queue := newPriorityQueue[K]()
+	queue.Push(source, 0)
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Pop()
+		finalized[vertex] = struct{}{}
+
+		if vertex == target {
+			break
+		}
+
+		for adjacency, edge := range y.adjacencyMap[vertex] {
+			if _, ok := finalized[adjacency]; ok {
+				continue
+			}
+			if _, ok := removedVertices[adjacency]; ok {
+				continue
+			}
+			if y.isRemoved(removedEdges, vertex, adjacency) {
+				continue
+			}
+
+			weight := weights[vertex] + y.weight(edge)
+
+			if current, ok := weights[adjacency]; !ok || weight < current {
+				weights[adjacency] = weight
+				predecessors[adjacency] = vertex
+				queue.Push(adjacency, weight)
+				queue.UpdatePriority(adjacency, weight)
+			}
+		}
+	}
+
+	if _, ok := finalized[target]; !ok {
+		return nil, false
+	}
+
+	path := []K{target}
+	for current := target; current != source; {
+		current = predecessors[current]
+		path = append([]K{current}, path...)
+	}
+
+	return path, true
+}
+
+func (y *yenSearch[K]) isRemoved(removedEdges map[EdgeKey[K]]struct{}, source, target K) bool {
+	if _, ok := removedEdges[EdgeKey[K]{Source: source, Target: target}]; ok {
+		return true
+	}
+	if !y.isDirected {
+		_, ok := removedEdges[EdgeKey[K]{Source: target, Target: source}]
+		return ok
+	}
+	return false
+}
+
+func (y *yenSearch[K]) pathWeight(path []K) float64 {
+	weight := 0.0
+	for i := 1; i < len(path); i++ {
+		weight += y.weight(y.adjacencyMap[path[i-1]][path[i]])
+	}
+	return weight
+}
+
+func pathsAreEqual[K comparable](a, b []K) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsPath[K comparable](paths [][]K, path []K) bool {
+	for _, p := range paths {
+		if pathsAreEqual(p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsWeightedPath[K comparable](paths []weightedPath[K], path []K) bool {
+	for _, p := range paths {
+		if pathsAreEqual(p.path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// FundamentalCycles computes a fundamental cycle basis of the given graph, which
+// consists of the cycle that each edge outside of a spanning forest forms with
+// the forest. The direction of edges is ignored, so two edges (A,B) and (B,A)
+// form a cycle. Each cycle starts and ends with the source of its non-forest
+// edge, so a self-loop (A,A) forms the cycle [A A].
+func FundamentalCycles[K comparable, T any](g Graph[K, T]) ([][]K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not get edges: %w", err)
+	}
+
+	// For each vertex, neighbors contains the indices of its edges. Using the
+	// indices instead of the adjacent vertices allows to tell apart two edges
+	// (A,B) and (B,A) in a directed graph.
+	neighbors := make(map[K][]int, len(adjacencyMap))
+	for i, edge := range edges {
+		neighbors[edge.Source] = append(neighbors[edge.Source], i)
+		if edge.Source != edge.Target {
+			neighbors[edge.Target] = append(neighbors[edge.Target], i)
+		}
+	}
+
+	parents := make(map[K]K, len(adjacencyMap))
+	depths := make(map[K]int, len(adjacencyMap))
+	treeEdges := make(map[int]struct{}, len(adjacencyMap))
+
+	for root := range adjacencyMap {
+		if _, ok := depths[root]; ok {
+			continue
+		}
+
+		depths[root] = 0
+		queue := []K{root}
+
+		for len(queue) > 0 {
+			vertex := queue[0]
+			queue = queue[1:]
+
+			for _, i := range neighbors[vertex] {
+				adjacency := edges[i].Target
+				if adjacency == vertex {
+					adjacency = edges[i].Source
+				}
+
+				if _, ok := depths[adjacency]; ok {
+					continue
+				}
+
+				parents[adjacency] = vertex
+				depths[adjacency] = depths[vertex] + 1
+				treeEdges[i] = struct{}{}
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	cycles := make([][]K, 0, len(edges)-len(treeEdges))
+
+	for i, edge := range edges {
+		if _, ok := treeEdges[i]; ok {
+			continue
+		}
+
+		// Walk up from both ends of the edge until their lowest common ancestor
+		// has been reached. The source path leads from the source to the common
+		// ancestor, and the target path leads from the target to it.
+		source, target := edge.Source, edge.Target
+		sourcePath := []K{source}
+		targetPath := []K{target}
+
+		for source != target {
+			if depths[source] >= depths[target] {
+				source = parents[source]
+				sourcePath = append(sourcePath, source)
+			} else {
+				target = parents[target]
+				targetPath = append(targetPath, target)
+			}
+		}
+
+		cycle := make([]K, 0, len(sourcePath)+len(targetPath))
+		cycle = append(cycle, sourcePath...)
+
+		// The common ancestor already is the last vertex of the source path.
+		for j := len(targetPath) - 2; j >= 0; j-- {
+			cycle = append(cycle, targetPath[j])
+		}
+		cycle = append(cycle, edge.Source)
+
+		cycles = append(cycles, cycle)
+	}
+
+	return cycles, nil
 }
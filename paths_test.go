@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"errors"
+	"math"
 	"reflect"
 	"sort"
 	"testing"
@@ -83,6 +85,51 @@ func TestDirectedCreatesCycle(t *testing.T) {
 	}
 }
 
+func TestCreatesCycle_nonExistentVertex(t *testing.T) {
+	tests := map[string]struct {
+		sourceHash int
+		targetHash int
+	}{
+		"non-existent source": {
+			sourceHash: 4,
+			targetHash: 1,
+		},
+		"non-existent target": {
+			sourceHash: 1,
+			targetHash: 4,
+		},
+		"non-existent self-loop": {
+			sourceHash: 4,
+			targetHash: 4,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed(), PreventCycles(), AllowSelfLoops())
+
+			for _, vertex := range []int{1, 2, 3} {
+				_ = g.AddVertex(vertex)
+			}
+
+			_ = g.AddEdge(1, 2)
+			_ = g.AddEdge(2, 3)
+
+			// The generic implementation works on the predecessor map.
+			_, err := CreatesCycle(Graph[int, int](g), test.sourceHash, test.targetHash)
+			if !errors.Is(err, ErrVertexNotFound) {
+				t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+			}
+
+			// Adding an edge with PreventCycles uses the memory store's fast path.
+			err = g.AddEdge(test.sourceHash, test.targetHash)
+			if !errors.Is(err, ErrVertexNotFound) {
+				t.Errorf("expected fast path error %v, got %v", ErrVertexNotFound, err)
+			}
+		})
+	}
+}
+
 func TestUndirectedCreatesCycle(t *testing.T) {
 	tests := map[string]struct {
 		vertices     []int
@@ -265,6 +312,20 @@ func TestDirectedShortestPath(t *testing.T) {
 			targetHash:           "D",
 			expectedShortestPath: []string{"A", "B", "C", "D"},
 		},
+		"negative weight falls back to Bellman-Ford": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "D", Properties: EdgeProperties{Weight: 3}},
+				{Source: "C", Target: "B", Properties: EdgeProperties{Weight: -2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 1}},
+			},
+			isWeighted:           true,
+			sourceHash:           "A",
+			targetHash:           "D",
+			expectedShortestPath: []string{"A", "C", "B", "D"},
+		},
 	}
 
 	for name, test := range tests {
@@ -436,6 +497,480 @@ func TestUndirectedShortestPath(t *testing.T) {
 	}
 }
 
+func TestDistance(t *testing.T) {
+	tests := map[string]struct {
+		traits           []func(*Traits)
+		edges            []Edge[string]
+		source           string
+		target           string
+		expectedDistance float64
+		expectedError    error
+	}{
+		"directed weighted graph": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 3}},
+			},
+			source:           "A",
+			target:           "D",
+			expectedDistance: 6,
+		},
+		"undirected unweighted graph": {
+			traits: []func(*Traits){},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+				{Source: "C", Target: "D"},
+				{Source: "A", Target: "C"},
+			},
+			source:           "D",
+			target:           "A",
+			expectedDistance: 2,
+		},
+		"directed graph with negative weight": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: -2}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:           "A",
+			target:           "C",
+			expectedDistance: 1,
+		},
+		"source equals target": {
+			traits:           []func(*Traits){Directed()},
+			edges:            []Edge[string]{{Source: "A", Target: "B"}},
+			source:           "A",
+			target:           "A",
+			expectedDistance: 0,
+		},
+		"target not reachable": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "A", Properties: EdgeProperties{Weight: 1}},
+			},
+			source:        "A",
+			target:        "C",
+			expectedError: ErrTargetNotReachable,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range []string{"A", "B", "C", "D"} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			distance, err := Distance(g, test.source, test.target)
+
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf("expected error %v, got %v", test.expectedError, err)
+			}
+
+			if test.expectedError != nil {
+				if _, err := ShortestPath(g, test.source, test.target); !errors.Is(err, test.expectedError) {
+					t.Errorf("expected ShortestPath error %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+
+			if distance != test.expectedDistance {
+				t.Errorf("expected distance %v, got %v", test.expectedDistance, distance)
+			}
+		})
+	}
+}
+
+func TestShortestPathFunc(t *testing.T) {
+	tests := map[string]struct {
+		edges        []Edge[string]
+		shortestPath func(Graph[string, string], string, string, func(Edge[string]) float64) ([]string, error)
+		expectedPath []string
+	}{
+		"Dijkstra with weights from edge data": {
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 10, Data: 1.5}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 10, Data: 1.5}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 1, Data: 4.0}},
+			},
+			shortestPath: DijkstraShortestPathFunc[string, string],
+			expectedPath: []string{"A", "B", "C"},
+		},
+		"Bellman-Ford with negative weights from edge data": {
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1, Data: 3.0}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 1, Data: -5.0}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 1, Data: 1.0}},
+			},
+			shortestPath: BellmanFordShortestPathFunc[string, string],
+			expectedPath: []string{"A", "B", "C"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, Directed(), Weighted())
+
+			for _, vertex := range []string{"A", "B", "C"} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			path, err := test.shortestPath(g, "A", "C", func(edge Edge[string]) float64 {
+				return edge.Properties.Data.(float64)
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !reflect.DeepEqual(path, test.expectedPath) {
+				t.Errorf("expected path %v, got %v", test.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestDirectedDijkstraShortestPath_disconnected(t *testing.T) {
+	g := New(StringHash, Directed(), Weighted())
+
+	for _, vertex := range []string{"A", "B", "C", "D", "X", "Y", "Z"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	// X and Y are joined with A, but they can't be reached from A. Z is an
+	// isolated vertex.
+	_ = g.AddEdge("A", "B", EdgeWeight(4))
+	_ = g.AddEdge("A", "C", EdgeWeight(1))
+	_ = g.AddEdge("C", "B", EdgeWeight(2))
+	_ = g.AddEdge("B", "D", EdgeWeight(1))
+	_ = g.AddEdge("X", "Y", EdgeWeight(1))
+	_ = g.AddEdge("Y", "A", EdgeWeight(1))
+
+	expectedDistances := map[string]float64{
+		"A": 0,
+		"B": 3,
+		"C": 1,
+		"D": 4,
+		"X": math.Inf(1),
+		"Y": math.Inf(1),
+		"Z": math.Inf(1),
+	}
+
+	for target, expectedDistance := range expectedDistances {
+		path, err := DijkstraShortestPath(g, "A", target)
+
+		if math.IsInf(expectedDistance, 1) {
+			if !errors.Is(err, ErrTargetNotReachable) {
+				t.Errorf("expected error %v for %v, got %v", ErrTargetNotReachable, target, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("failed to get shortest path to %v: %s", target, err.Error())
+		}
+
+		weight, err := PathWeight(g, path)
+		if err != nil {
+			t.Fatalf("failed to get path weight: %s", err.Error())
+		}
+
+		if float64(weight) != expectedDistance {
+			t.Errorf("expected distance %v for %v, got %v (path %v)", expectedDistance, target, weight, path)
+		}
+	}
+
+	streamed := make(map[string]float64)
+
+	DijkstraStream(g, "A")(func(vertex string, dist float64, _ func() []string, err error) bool {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		streamed[vertex] = dist
+		return true
+	})
+
+	for vertex, expectedDistance := range expectedDistances {
+		dist, ok := streamed[vertex]
+		if !ok {
+			dist = math.Inf(1)
+		}
+		if dist != expectedDistance {
+			t.Errorf("expected streamed distance %v for %v, got %v", expectedDistance, vertex, dist)
+		}
+	}
+}
+
+func TestDirectedDijkstraStream(t *testing.T) {
+	tests := map[string]struct {
+		vertices          []string
+		edges             []Edge[string]
+		isWeighted        bool
+		source            string
+		expectedDistances map[string]float64
+		expectedPaths     map[string][]string
+		shouldFail        bool
+	}{
+		"graph as on img/dijkstra.svg": {
+			vertices: []string{"A", "B", "C", "D", "E", "F", "G"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 4}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 3}},
+				{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 5}},
+				{Source: "G", Target: "B", Properties: EdgeProperties{Weight: 2}},
+			},
+			isWeighted: true,
+			source:     "A",
+			expectedDistances: map[string]float64{
+				"A": 0, "B": 6, "C": 3, "D": 7, "E": 4, "F": 2, "G": 7,
+			},
+			expectedPaths: map[string][]string{
+				"A": {"A"},
+				"B": {"A", "C", "E", "B"},
+				"E": {"A", "C", "E"},
+			},
+		},
+		"unweighted graph with unreachable vertex": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+			},
+			source: "A",
+			expectedDistances: map[string]float64{
+				"A": 0, "B": 1, "C": 2,
+			},
+			expectedPaths: map[string][]string{
+				"C": {"A", "B", "C"},
+			},
+		},
+		"source not found": {
+			vertices:   []string{"A"},
+			source:     "X",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, Directed())
+			g.(*directed[string, string]).traits.IsWeighted = test.isWeighted
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			distances := make(map[string]float64)
+			paths := make(map[string][]string)
+			last := 0.0
+			var streamErr error
+
+			DijkstraStream(g, test.source)(func(vertex string, dist float64, path func() []string, err error) bool {
+				if err != nil {
+					streamErr = err
+					return false
+				}
+				if dist < last {
+					t.Errorf("expected non-decreasing distances, got %v after %v", dist, last)
+				}
+				last = dist
+				distances[vertex] = dist
+				paths[vertex] = path()
+				return true
+			})
+
+			if test.shouldFail != (streamErr != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, streamErr)
+			}
+
+			if len(distances) != len(test.expectedDistances) {
+				t.Errorf("expected %d yielded vertices, got %d", len(test.expectedDistances), len(distances))
+			}
+
+			for vertex, expectedDistance := range test.expectedDistances {
+				if distances[vertex] != expectedDistance {
+					t.Errorf("expected distance %v for %v, got %v", expectedDistance, vertex, distances[vertex])
+				}
+			}
+
+			for vertex, expectedPath := range test.expectedPaths {
+				if !reflect.DeepEqual(expectedPath, paths[vertex]) {
+					t.Errorf("expected path %v for %v, got %v", expectedPath, vertex, paths[vertex])
+				}
+			}
+		})
+	}
+}
+
+func TestDijkstraStream_stopEarly(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 1; i <= 5; i++ {
+		_ = g.AddVertex(i)
+	}
+	for i := 1; i < 5; i++ {
+		_ = g.AddEdge(i, i+1)
+	}
+
+	yielded := 0
+
+	DijkstraStream(g, 1)(func(_ int, _ float64, _ func() []int, _ error) bool {
+		yielded++
+		return yielded < 2
+	})
+
+	if yielded != 2 {
+		t.Errorf("expected stream to stop after 2 vertices, got %d", yielded)
+	}
+}
+
+func TestDijkstraWithTrace(t *testing.T) {
+	tests := map[string]struct {
+		vertices          []string
+		edges             []Edge[string]
+		isWeighted        bool
+		source            string
+		expectedOrder     int
+		expectedDistances map[string]float64
+		shouldFail        bool
+	}{
+		"graph as on img/dijkstra.svg": {
+			vertices: []string{"A", "B", "C", "D", "E", "F", "G"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 4}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 3}},
+				{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 5}},
+				{Source: "G", Target: "B", Properties: EdgeProperties{Weight: 2}},
+			},
+			isWeighted:    true,
+			source:        "A",
+			expectedOrder: 7,
+			expectedDistances: map[string]float64{
+				"A": 0, "B": 6, "C": 3, "D": 7, "E": 4, "F": 2, "G": 7,
+			},
+		},
+		"unweighted graph with unreachable vertex": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 10}},
+				{Source: "B", Target: "C"},
+			},
+			source:        "A",
+			expectedOrder: 3,
+			expectedDistances: map[string]float64{
+				"A": 0, "B": 1, "C": 2,
+			},
+		},
+		"non-existent source": {
+			vertices:   []string{"A"},
+			source:     "B",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, Directed())
+			g.(*directed[string, string]).traits.IsWeighted = test.isWeighted
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			predecessors, distances, order, err := DijkstraWithTrace(g, test.source)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if len(order) != test.expectedOrder {
+				t.Fatalf("expected %d finalized vertices, got %v", test.expectedOrder, order)
+			}
+
+			if order[0] != test.source {
+				t.Errorf("expected source %v to be finalized first, got %v", test.source, order[0])
+			}
+
+			for i := 1; i < len(order); i++ {
+				if distances[order[i]] < distances[order[i-1]] {
+					t.Errorf("expected order to be sorted by distance, got %v", order)
+				}
+			}
+
+			for vertex, expectedDistance := range test.expectedDistances {
+				if distances[vertex] != expectedDistance {
+					t.Errorf("expected distance %v for %v, got %v", expectedDistance, vertex, distances[vertex])
+				}
+			}
+
+			// Following the predecessors back to the source has to yield a
+			// shortest path for each vertex.
+			for _, vertex := range order {
+				path := []string{vertex}
+				for current := vertex; current != test.source; {
+					current = predecessors[current]
+					path = append([]string{current}, path...)
+				}
+
+				expectedPath, err := DijkstraShortestPath(g, test.source, vertex)
+				if err != nil {
+					t.Fatalf("failed to get shortest path: %s", err.Error())
+				}
+
+				if !pathsAreEqual(path, expectedPath) {
+					t.Errorf("expected path %v for %v, got %v", expectedPath, vertex, path)
+				}
+			}
+		})
+	}
+}
+
 func Test_BellmanFord(t *testing.T) {
 	tests := map[string]struct {
 		vertices             []string
@@ -447,6 +982,17 @@ func Test_BellmanFord(t *testing.T) {
 		expectedShortestPath []string
 		shouldFail           bool
 	}{
+		"weights summing above MaxInt32": {
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 2000000000}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2000000000}},
+			},
+			isWeighted:           true,
+			sourceHash:           "A",
+			targetHash:           "C",
+			expectedShortestPath: []string{"A", "B", "C"},
+		},
 		"graph as on img/dijkstra.svg": {
 			vertices: []string{"A", "B", "C", "D", "E", "F", "G"},
 			edges: []Edge[string]{
@@ -586,7 +1132,7 @@ func Test_BellmanFord(t *testing.T) {
 			}
 		}
 
-		shortestPath, err := bellmanFord(graph, test.sourceHash, test.targetHash, nil)
+		shortestPath, err := bellmanFord(graph, test.sourceHash, test.targetHash, nil, edgeWeight[string])
 
 		if test.shouldFail != (err != nil) {
 			t.Fatalf("%s: error expectancy doesn't match: expected %v, got %v (error: %v)", name, test.shouldFail, (err != nil), err)
@@ -604,16 +1150,483 @@ func Test_BellmanFord(t *testing.T) {
 	}
 }
 
-func TestDirectedStronglyConnectedComponents(t *testing.T) {
+func TestBellmanFordShortestPath(t *testing.T) {
 	tests := map[string]struct {
-		vertices     []int
-		edges        []Edge[int]
-		expectedSCCs [][]int
+		isDirected   bool
+		vertices     []string
+		edges        []Edge[string]
+		source       string
+		target       string
+		expectedPath []string
+		shouldFail   bool
 	}{
-		"graph with SCCs as on img/scc.svg": {
-			vertices: []int{1, 2, 3, 4, 5, 6, 7, 8},
-			edges: []Edge[int]{
-				{Source: 1, Target: 2},
+		"negative edge weight": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: -3}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:       "A",
+			target:       "C",
+			expectedPath: []string{"A", "B", "C"},
+		},
+		"target not reachable": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+			},
+			source:     "A",
+			target:     "C",
+			shouldFail: true,
+		},
+		"undirected graph": {
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+			},
+			source:     "A",
+			target:     "B",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, Weighted())
+			if test.isDirected {
+				g = New(StringHash, Directed(), Weighted())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			path, err := BellmanFordShortestPath(g, test.source, test.target)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("error expectancy doesn't match: expected %v, got %v (error: %v)", test.shouldFail, err != nil, err)
+			}
+
+			if !slicesAreEqual(path, test.expectedPath) {
+				t.Errorf("path doesn't match: expected %v, got %v", test.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestBellmanFordShortestPath_negativeCycleError(t *testing.T) {
+	g := New(StringHash, Directed(), Weighted())
+
+	for _, vertex := range []string{"A", "B", "C", "D", "E"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	for _, edge := range []Edge[string]{
+		{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+		{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 4}},
+		{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2}},
+		{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 6}},
+		{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 3}},
+		{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 2}},
+		{Source: "D", Target: "E", Properties: EdgeProperties{Weight: -3}},
+		{Source: "E", Target: "C", Properties: EdgeProperties{Weight: -3}},
+	} {
+		_ = g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight))
+	}
+
+	_, err := BellmanFordShortestPath(g, "A", "E")
+
+	if !errors.Is(err, ErrNegativeCycle) {
+		t.Fatalf("expected ErrNegativeCycle, got %v", err)
+	}
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("expected NegativeCycleError, got %T", err)
+	}
+
+	cycle := cycleErr.Cycle
+
+	if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] {
+		t.Fatalf("expected closed cycle, got %v", cycle)
+	}
+
+	weight, err := PathWeight(g, cycle)
+	if err != nil {
+		t.Fatalf("expected cycle to consist of existing edges: %s", err.Error())
+	}
+
+	if weight >= 0 {
+		t.Errorf("expected negative cycle weight, got %d for %v", weight, cycle)
+	}
+}
+
+func TestFindNegativeCycle(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []string
+		edges         []Edge[string]
+		expectedFound bool
+		shouldFail    bool
+	}{
+		"negative cycle": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C", "D", "E"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 4}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 6}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 3}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "E", Properties: EdgeProperties{Weight: -3}},
+				{Source: "E", Target: "C", Properties: EdgeProperties{Weight: -3}},
+			},
+			expectedFound: true,
+		},
+		"negative cycle not reachable from other vertices": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "C", Properties: EdgeProperties{Weight: -3}},
+			},
+			expectedFound: true,
+		},
+		"negative self-loop": {
+			traits:   []func(*Traits){Directed(), Weighted(), AllowSelfLoops()},
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "B", Properties: EdgeProperties{Weight: -1}},
+			},
+			expectedFound: true,
+		},
+		"negative weights without negative cycle": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: -2}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "A", Properties: EdgeProperties{Weight: 3}},
+			},
+			expectedFound: false,
+		},
+		"undirected graph": {
+			traits:     []func(*Traits){Weighted()},
+			vertices:   []string{"A", "B"},
+			edges:      []Edge[string]{{Source: "A", Target: "B", Properties: EdgeProperties{Weight: -1}}},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			cycle, found, err := FindNegativeCycle(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if found != test.expectedFound {
+				t.Fatalf("expected found == %v, got %v (cycle %v)", test.expectedFound, found, cycle)
+			}
+
+			if !found {
+				return
+			}
+
+			if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] {
+				t.Fatalf("expected closed cycle, got %v", cycle)
+			}
+
+			weight, err := PathWeight(g, cycle)
+			if err != nil {
+				t.Fatalf("expected cycle to consist of existing edges: %s", err.Error())
+			}
+
+			if weight >= 0 {
+				t.Errorf("expected negative cycle weight, got %d for %v", weight, cycle)
+			}
+		})
+	}
+}
+
+func TestPathWeight(t *testing.T) {
+	tests := map[string]struct {
+		path           []string
+		expectedWeight int
+		expectedErr    error
+	}{
+		"shortest path as on img/dijkstra.svg": {
+			path:           []string{"A", "C", "E", "B"},
+			expectedWeight: 6,
+		},
+		"two-vertex path": {
+			path:           []string{"F", "G"},
+			expectedWeight: 5,
+		},
+		"single vertex": {
+			path:           []string{"A"},
+			expectedWeight: 0,
+		},
+		"empty path": {
+			path:           []string{},
+			expectedWeight: 0,
+		},
+		"missing edge": {
+			path:        []string{"A", "B"},
+			expectedErr: ErrEdgeNotFound,
+		},
+	}
+
+	g := New(StringHash, Directed(), Weighted())
+
+	for _, vertex := range []string{"A", "B", "C", "D", "E", "F", "G"} {
+		_ = g.AddVertex(vertex, VertexWeight(100))
+	}
+
+	for _, edge := range []Edge[string]{
+		{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+		{Source: "A", Target: "F", Properties: EdgeProperties{Weight: 2}},
+		{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 4}},
+		{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 1}},
+		{Source: "C", Target: "F", Properties: EdgeProperties{Weight: 2}},
+		{Source: "D", Target: "B", Properties: EdgeProperties{Weight: 1}},
+		{Source: "E", Target: "B", Properties: EdgeProperties{Weight: 2}},
+		{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 3}},
+		{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 5}},
+		{Source: "G", Target: "B", Properties: EdgeProperties{Weight: 2}},
+	} {
+		_ = g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight))
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			weight, err := PathWeight(g, test.path)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if weight != test.expectedWeight {
+				t.Errorf("expected weight %d, got %d", test.expectedWeight, weight)
+			}
+		})
+	}
+}
+
+func TestPathEdges(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		edges         []Edge[string]
+		path          []string
+		expectedEdges []Edge[string]
+		expectedErr   error
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2, Attributes: map[string]string{"color": "red"}}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 9}},
+			},
+			path: []string{"A", "B", "C"},
+			expectedEdges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2, Attributes: map[string]string{"color": "red"}}},
+			},
+		},
+		"undirected graph traversed against edge direction": {
+			traits: []func(*Traits){Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 2}},
+			},
+			path: []string{"C", "B", "A"},
+			expectedEdges: []Edge[string]{
+				{Source: "C", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 3}},
+			},
+		},
+		"single vertex": {
+			traits:        []func(*Traits){},
+			edges:         []Edge[string]{{Source: "A", Target: "B"}},
+			path:          []string{"A"},
+			expectedEdges: []Edge[string]{},
+		},
+		"missing edge": {
+			traits:      []func(*Traits){Directed()},
+			edges:       []Edge[string]{{Source: "A", Target: "B"}},
+			path:        []string{"B", "A"},
+			expectedErr: ErrEdgeNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, edge := range test.edges {
+				_ = g.AddVertex(edge.Source)
+				_ = g.AddVertex(edge.Target)
+
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			edges, err := PathEdges(g, test.path)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if len(edges) != len(test.expectedEdges) {
+				t.Fatalf("expected %d edges, got %d: %v", len(test.expectedEdges), len(edges), edges)
+			}
+
+			for i, expected := range test.expectedEdges {
+				edge := edges[i]
+
+				if edge.Source != expected.Source || edge.Target != expected.Target {
+					t.Errorf("expected edge (%v, %v) at index %d, got (%v, %v)", expected.Source, expected.Target, i, edge.Source, edge.Target)
+				}
+
+				if edge.Properties.Weight != expected.Properties.Weight {
+					t.Errorf("expected weight %d at index %d, got %d", expected.Properties.Weight, i, edge.Properties.Weight)
+				}
+
+				if !mapsAreEqual(edge.Properties.Attributes, expected.Properties.Attributes) {
+					t.Errorf("expected attributes %v at index %d, got %v", expected.Properties.Attributes, i, edge.Properties.Attributes)
+				}
+			}
+		})
+	}
+}
+
+func TestReversePath(t *testing.T) {
+	tests := map[string]struct {
+		path     []int
+		expected []int
+	}{
+		"path with multiple vertices": {
+			path:     []int{1, 2, 3, 4},
+			expected: []int{4, 3, 2, 1},
+		},
+		"single vertex": {
+			path:     []int{1},
+			expected: []int{1},
+		},
+		"empty path": {
+			path:     []int{},
+			expected: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			original := make([]int, len(test.path))
+			copy(original, test.path)
+
+			reversed := ReversePath(test.path)
+
+			if !pathsAreEqual(reversed, test.expected) {
+				t.Errorf("expected path %v, got %v", test.expected, reversed)
+			}
+
+			if !pathsAreEqual(test.path, original) {
+				t.Errorf("expected original path %v to remain unchanged, got %v", original, test.path)
+			}
+		})
+	}
+}
+
+func TestAppendPath(t *testing.T) {
+	tests := map[string]struct {
+		path       []int
+		other      []int
+		expected   []int
+		shouldFail bool
+	}{
+		"matching paths": {
+			path:     []int{1, 2, 3},
+			other:    []int{3, 4, 5},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		"single vertex paths": {
+			path:     []int{1},
+			other:    []int{1},
+			expected: []int{1},
+		},
+		"empty first path": {
+			path:     []int{},
+			other:    []int{1, 2},
+			expected: []int{1, 2},
+		},
+		"empty second path": {
+			path:     []int{1, 2},
+			other:    nil,
+			expected: []int{1, 2},
+		},
+		"paths that don't meet": {
+			path:       []int{1, 2},
+			other:      []int{3, 4},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			joined, err := AppendPath(test.path, test.other)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if !pathsAreEqual(joined, test.expected) {
+				t.Errorf("expected path %v, got %v", test.expected, joined)
+			}
+		})
+	}
+}
+
+func TestDirectedStronglyConnectedComponents(t *testing.T) {
+	tests := map[string]struct {
+		vertices     []int
+		edges        []Edge[int]
+		expectedSCCs [][]int
+	}{
+		"graph with SCCs as on img/scc.svg": {
+			vertices: []int{1, 2, 3, 4, 5, 6, 7, 8},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
 				{Source: 2, Target: 3},
 				{Source: 2, Target: 5},
 				{Source: 2, Target: 6},
@@ -662,6 +1675,113 @@ func TestDirectedStronglyConnectedComponents(t *testing.T) {
 	}
 }
 
+func TestDirectedSCCQuotient(t *testing.T) {
+	tests := map[string]struct {
+		vertices                []int
+		edges                   []Edge[int]
+		expectedRepresentatives map[int]int
+		expectedEdges           []Edge[int]
+	}{
+		"graph with SCCs as on img/scc.svg": {
+			vertices: []int{1, 2, 3, 4, 5, 6, 7, 8},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 5},
+				{Source: 2, Target: 6},
+				{Source: 3, Target: 4},
+				{Source: 3, Target: 7},
+				{Source: 4, Target: 3},
+				{Source: 4, Target: 8},
+				{Source: 5, Target: 1},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 7},
+				{Source: 7, Target: 6},
+				{Source: 8, Target: 4},
+				{Source: 8, Target: 7},
+			},
+			expectedRepresentatives: map[int]int{1: 1, 2: 1, 5: 1, 3: 3, 4: 3, 8: 3, 6: 6, 7: 6},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 6},
+				{Source: 3, Target: 6},
+			},
+		},
+		"acyclic graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedRepresentatives: map[int]int{1: 1, 2: 2, 3: 3},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			smallest := func(component []int) int {
+				sort.Ints(component)
+				return component[0]
+			}
+
+			quotient := NewLike(g)
+
+			representatives, err := SCCQuotient(g, smallest, quotient)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !mapsAreEqual(test.expectedRepresentatives, representatives) || len(representatives) != len(test.expectedRepresentatives) {
+				t.Errorf("expected representatives %v, got %v", test.expectedRepresentatives, representatives)
+			}
+
+			if _, err := TopologicalSort(quotient); err != nil {
+				t.Errorf("expected quotient graph to be acyclic: %s", err.Error())
+			}
+
+			size, _ := quotient.Size()
+
+			if size != len(test.expectedEdges) {
+				t.Errorf("expected %d edges, got %d", len(test.expectedEdges), size)
+			}
+
+			for _, edge := range test.expectedEdges {
+				if _, err := quotient.Edge(edge.Source, edge.Target); err != nil {
+					t.Errorf("expected edge (%v, %v): %s", edge.Source, edge.Target, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestDirectedSCCQuotient_invalidRepresentative(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+
+	_, err := SCCQuotient(g, func(component []int) int { return 42 }, NewLike(g))
+	if err == nil {
+		t.Errorf("expected error for representative outside of its component")
+	}
+}
+
 func TestUndirectedStronglyConnectedComponents(t *testing.T) {
 	tests := map[string]struct {
 		expectedSCCs [][]int
@@ -762,7 +1882,7 @@ func TestAllPathsBetween(t *testing.T) {
 			name: "directed with self cycle",
 			args: args[int, int]{
 				g: func() Graph[int, int] {
-					g := New(IntHash, Directed())
+					g := New(IntHash, Directed(), AllowSelfLoops())
 					for i := 0; i <= 8; i++ {
 						_ = g.AddVertex(i)
 					}
@@ -785,27 +1905,105 @@ func TestAllPathsBetween(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "directed with unuseable cycle",
+			name: "directed with unuseable cycle",
+			args: args[int, int]{
+				g: func() Graph[int, int] {
+					g := New(IntHash, Directed())
+					for i := 0; i <= 8; i++ {
+						_ = g.AddVertex(i)
+					}
+					_ = g.AddEdge(0, 1)
+					_ = g.AddEdge(1, 2)
+					_ = g.AddEdge(2, 3)
+					_ = g.AddEdge(3, 2)
+					_ = g.AddEdge(0, 2)
+					_ = g.AddEdge(3, 0)
+					return g
+				}(),
+				start: 0,
+				end:   2,
+			},
+			want: [][]int{
+				{0, 1, 2},
+				{0, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "directed with multiple cycles through start",
+			args: args[int, int]{
+				g: func() Graph[int, int] {
+					g := New(IntHash, Directed(), AllowSelfLoops())
+					for i := 0; i <= 5; i++ {
+						_ = g.AddVertex(i)
+					}
+					_ = g.AddEdge(0, 0)
+					_ = g.AddEdge(0, 1)
+					_ = g.AddEdge(1, 0)
+					_ = g.AddEdge(0, 2)
+					_ = g.AddEdge(2, 3)
+					_ = g.AddEdge(3, 0)
+					_ = g.AddEdge(3, 1)
+					_ = g.AddEdge(2, 4)
+					_ = g.AddEdge(4, 4)
+					_ = g.AddEdge(5, 0)
+					return g
+				}(),
+				start: 0,
+				end:   0,
+			},
+			want: [][]int{
+				{0, 0},
+				{0, 1, 0},
+				{0, 2, 3, 0},
+				{0, 2, 3, 1, 0},
+			},
+			wantErr: false,
+		},
+		{
+			name: "directed with cycle through end",
+			args: args[int, int]{
+				g: func() Graph[int, int] {
+					g := New(IntHash, Directed())
+					for i := 0; i <= 3; i++ {
+						_ = g.AddVertex(i)
+					}
+					_ = g.AddEdge(0, 1)
+					_ = g.AddEdge(1, 2)
+					_ = g.AddEdge(2, 3)
+					_ = g.AddEdge(3, 1)
+					_ = g.AddEdge(3, 0)
+					return g
+				}(),
+				start: 0,
+				end:   2,
+			},
+			want: [][]int{
+				{0, 1, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "undirected with start as end",
 			args: args[int, int]{
 				g: func() Graph[int, int] {
-					g := New(IntHash, Directed())
-					for i := 0; i <= 8; i++ {
+					g := New(IntHash)
+					for i := 0; i <= 2; i++ {
 						_ = g.AddVertex(i)
 					}
 					_ = g.AddEdge(0, 1)
 					_ = g.AddEdge(1, 2)
-					_ = g.AddEdge(2, 3)
-					_ = g.AddEdge(3, 2)
-					_ = g.AddEdge(0, 2)
-					_ = g.AddEdge(3, 0)
+					_ = g.AddEdge(2, 0)
 					return g
 				}(),
 				start: 0,
-				end:   2,
+				end:   0,
 			},
 			want: [][]int{
-				{0, 1, 2},
-				{0, 2},
+				{0, 1, 0},
+				{0, 2, 0},
+				{0, 1, 2, 0},
+				{0, 2, 1, 0},
 			},
 			wantErr: false,
 		},
@@ -915,6 +2113,677 @@ func TestAllPathsBetween(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("AllPathsBetween() got = %v, want %v", got, tt.want)
 			}
+
+			walked := make([][]int, 0)
+			err = WalkPathsBetween(tt.args.g, tt.args.start, tt.args.end, func(path []int) bool {
+				walked = append(walked, path)
+				return false
+			})
+			if err != nil {
+				t.Fatalf("WalkPathsBetween() error = %v", err)
+			}
+
+			sort.Slice(walked, func(i, j int) bool {
+				return toStr(walked[i]) < toStr(walked[j])
+			})
+
+			if !reflect.DeepEqual(walked, got) {
+				t.Errorf("WalkPathsBetween() got = %v, want %v", walked, got)
+			}
+		})
+	}
+}
+
+func TestWalkPathsBetween_stop(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 1; i <= 4; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	_ = g.AddEdge(1, 2)
+	_ = g.AddEdge(1, 3)
+	_ = g.AddEdge(2, 4)
+	_ = g.AddEdge(3, 4)
+	_ = g.AddEdge(1, 4)
+
+	visited := 0
+
+	err := WalkPathsBetween(g, 1, 4, func(path []int) bool {
+		visited++
+		if path[0] != 1 || path[len(path)-1] != 4 {
+			t.Errorf("expected path from 1 to 4, got %v", path)
+		}
+		return visited == 2
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if visited != 2 {
+		t.Errorf("expected enumeration to stop after 2 paths, got %d", visited)
+	}
+}
+
+func TestKShortestPathsAvoiding(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []string
+		edges         []Edge[string]
+		source        string
+		target        string
+		k             int
+		avoid         map[string]struct{}
+		expectedPaths [][]string
+		expectedErr   error
+	}{
+		"weighted directed graph": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"C", "D", "E", "F", "G", "H"},
+			edges: []Edge[string]{
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 3}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "F", Properties: EdgeProperties{Weight: 4}},
+				{Source: "E", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "E", Target: "G", Properties: EdgeProperties{Weight: 3}},
+				{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 2}},
+				{Source: "F", Target: "H", Properties: EdgeProperties{Weight: 1}},
+				{Source: "G", Target: "H", Properties: EdgeProperties{Weight: 2}},
+			},
+			source: "C",
+			target: "H",
+			k:      3,
+			avoid:  map[string]struct{}{},
+			expectedPaths: [][]string{
+				{"C", "E", "F", "H"},
+				{"C", "E", "G", "H"},
+				{"C", "D", "F", "H"},
+			},
+		},
+		"avoided vertex": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"C", "D", "E", "F", "G", "H"},
+			edges: []Edge[string]{
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 3}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "F", Properties: EdgeProperties{Weight: 4}},
+				{Source: "E", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "E", Target: "G", Properties: EdgeProperties{Weight: 3}},
+				{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 2}},
+				{Source: "F", Target: "H", Properties: EdgeProperties{Weight: 1}},
+				{Source: "G", Target: "H", Properties: EdgeProperties{Weight: 2}},
+			},
+			source: "C",
+			target: "H",
+			k:      5,
+			avoid:  map[string]struct{}{"E": {}},
+			expectedPaths: [][]string{
+				{"C", "D", "F", "H"},
+				{"C", "D", "F", "G", "H"},
+			},
+		},
+		"unweighted undirected graph": {
+			traits:   []func(*Traits){},
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "D"},
+				{Source: "A", Target: "C"},
+				{Source: "C", Target: "B"},
+			},
+			source: "A",
+			target: "D",
+			k:      5,
+			avoid:  map[string]struct{}{},
+			expectedPaths: [][]string{
+				{"A", "B", "D"},
+				{"A", "C", "B", "D"},
+			},
+		},
+		"avoided target": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+			},
+			source:      "A",
+			target:      "B",
+			k:           2,
+			avoid:       map[string]struct{}{"B": {}},
+			expectedErr: ErrTargetNotReachable,
+		},
+		"non-existent source": {
+			traits:      []func(*Traits){Directed()},
+			vertices:    []string{"A", "B"},
+			source:      "X",
+			target:      "B",
+			k:           2,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			paths, err := KShortestPathsAvoiding(g, test.source, test.target, test.k, test.avoid)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if len(paths) != len(test.expectedPaths) {
+				t.Fatalf("expected %d paths %v, got %d paths %v", len(test.expectedPaths), test.expectedPaths, len(paths), paths)
+			}
+
+			previousWeight := 0
+			for i, path := range paths {
+				for _, vertex := range path {
+					if _, ok := test.avoid[vertex]; ok {
+						t.Errorf("path %v passes through avoided vertex %v", path, vertex)
+					}
+				}
+
+				weight, err := PathWeight(g, path)
+				if err != nil {
+					t.Fatalf("failed to compute path weight: %s", err.Error())
+				}
+				if weight < previousWeight {
+					t.Errorf("expected paths to be ordered by weight, got %v", paths)
+				}
+				previousWeight = weight
+
+				if !pathsAreEqual(path, test.expectedPaths[i]) {
+					t.Errorf("expected path %v at index %d, got %v", test.expectedPaths[i], i, path)
+				}
+			}
+		})
+	}
+}
+
+func TestSecondShortestPath(t *testing.T) {
+	tests := map[string]struct {
+		traits         []func(*Traits)
+		vertices       []string
+		edges          []Edge[string]
+		source         string
+		target         string
+		expectedPath   []string
+		expectedWeight float64
+		expectedErr    error
+	}{
+		"weighted directed graph": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"C", "D", "E", "F", "G", "H"},
+			edges: []Edge[string]{
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 3}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "F", Properties: EdgeProperties{Weight: 4}},
+				{Source: "E", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "E", Target: "G", Properties: EdgeProperties{Weight: 3}},
+				{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 2}},
+				{Source: "F", Target: "H", Properties: EdgeProperties{Weight: 1}},
+				{Source: "G", Target: "H", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:         "C",
+			target:         "H",
+			expectedPath:   []string{"C", "E", "G", "H"},
+			expectedWeight: 7,
+		},
+		"unweighted undirected graph": {
+			traits:   []func(*Traits){},
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "D"},
+				{Source: "A", Target: "C"},
+				{Source: "C", Target: "B"},
+			},
+			source:         "A",
+			target:         "D",
+			expectedPath:   []string{"A", "C", "B", "D"},
+			expectedWeight: 3,
+		},
+		"only one path": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+				{Source: "C", Target: "A"},
+			},
+			source:      "A",
+			target:      "C",
+			expectedErr: ErrNoAlternativePath,
+		},
+		"unreachable target": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "B", Target: "A"},
+			},
+			source:      "A",
+			target:      "B",
+			expectedErr: ErrTargetNotReachable,
+		},
+		"non-existent target": {
+			traits:      []func(*Traits){Directed()},
+			vertices:    []string{"A", "B"},
+			source:      "A",
+			target:      "X",
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			path, weight, err := SecondShortestPath(g, test.source, test.target)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if !pathsAreEqual(path, test.expectedPath) {
+				t.Errorf("expected path %v, got %v", test.expectedPath, path)
+			}
+
+			if weight != test.expectedWeight {
+				t.Errorf("expected weight %v, got %v", test.expectedWeight, weight)
+			}
+		})
+	}
+}
+
+func TestShortestPathWithHopLimit(t *testing.T) {
+	weightedEdges := []Edge[string]{
+		{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+		{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 1}},
+		{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 1}},
+		{Source: "D", Target: "E", Properties: EdgeProperties{Weight: 1}},
+		{Source: "A", Target: "F", Properties: EdgeProperties{Weight: 5}},
+		{Source: "F", Target: "E", Properties: EdgeProperties{Weight: 5}},
+		{Source: "A", Target: "E", Properties: EdgeProperties{Weight: 20}},
+	}
+
+	tests := map[string]struct {
+		traits         []func(*Traits)
+		vertices       []string
+		edges          []Edge[string]
+		source         string
+		target         string
+		maxHops        int
+		expectedPath   []string
+		expectedWeight float64
+		expectedErr    error
+		shouldFail     bool
+	}{
+		"hop limit allows the shortest path": {
+			traits:         []func(*Traits){Directed(), Weighted()},
+			vertices:       []string{"A", "B", "C", "D", "E", "F"},
+			edges:          weightedEdges,
+			source:         "A",
+			target:         "E",
+			maxHops:        4,
+			expectedPath:   []string{"A", "B", "C", "D", "E"},
+			expectedWeight: 4,
+		},
+		"hop limit excludes the shortest path": {
+			traits:         []func(*Traits){Directed(), Weighted()},
+			vertices:       []string{"A", "B", "C", "D", "E", "F"},
+			edges:          weightedEdges,
+			source:         "A",
+			target:         "E",
+			maxHops:        3,
+			expectedPath:   []string{"A", "F", "E"},
+			expectedWeight: 10,
+		},
+		"hop limit of one": {
+			traits:         []func(*Traits){Directed(), Weighted()},
+			vertices:       []string{"A", "B", "C", "D", "E", "F"},
+			edges:          weightedEdges,
+			source:         "A",
+			target:         "E",
+			maxHops:        1,
+			expectedPath:   []string{"A", "E"},
+			expectedWeight: 20,
+		},
+		"target not reachable within hop limit": {
+			traits:      []func(*Traits){},
+			vertices:    []string{"A", "B", "C"},
+			edges:       []Edge[string]{{Source: "A", Target: "B"}, {Source: "B", Target: "C"}},
+			source:      "A",
+			target:      "C",
+			maxHops:     1,
+			expectedErr: ErrTargetNotReachable,
+			shouldFail:  true,
+		},
+		"negative edge weight": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 4}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: -3}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:         "A",
+			target:         "C",
+			maxHops:        2,
+			expectedPath:   []string{"A", "B", "C"},
+			expectedWeight: 1,
+		},
+		"source equals target": {
+			traits:         []func(*Traits){},
+			vertices:       []string{"A"},
+			source:         "A",
+			target:         "A",
+			maxHops:        0,
+			expectedPath:   []string{"A"},
+			expectedWeight: 0,
+		},
+		"negative hop limit": {
+			traits:     []func(*Traits){},
+			vertices:   []string{"A"},
+			source:     "A",
+			target:     "A",
+			maxHops:    -1,
+			shouldFail: true,
+		},
+		"non-existent source": {
+			traits:      []func(*Traits){},
+			vertices:    []string{"A"},
+			source:      "X",
+			target:      "A",
+			maxHops:     1,
+			expectedErr: ErrVertexNotFound,
+			shouldFail:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			path, weight, err := ShortestPathWithHopLimit(g, test.source, test.target, test.maxHops)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if !pathsAreEqual(path, test.expectedPath) {
+				t.Errorf("expected path %v, got %v", test.expectedPath, path)
+			}
+
+			if weight != test.expectedWeight {
+				t.Errorf("expected weight %v, got %v", test.expectedWeight, weight)
+			}
+		})
+	}
+}
+
+func TestAllShortestPaths(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []string
+		edges         []Edge[string]
+		source        string
+		target        string
+		expectedPaths [][]string
+		expectedErr   error
+	}{
+		"weighted directed graph with ties": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C", "D", "E"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "D", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "E", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "D", Properties: EdgeProperties{Weight: 3}},
+			},
+			source: "A",
+			target: "D",
+			expectedPaths: [][]string{
+				{"A", "B", "D"},
+				{"A", "C", "D"},
+				{"A", "D"},
+			},
+		},
+		"unweighted undirected graph": {
+			traits:   []func(*Traits){},
+			vertices: []string{"A", "B", "C", "D", "E", "F"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "C"},
+				{Source: "B", Target: "D"},
+				{Source: "C", Target: "D"},
+				{Source: "D", Target: "E"},
+				{Source: "D", Target: "F"},
+				{Source: "E", Target: "F"},
+			},
+			source: "A",
+			target: "F",
+			expectedPaths: [][]string{
+				{"A", "B", "D", "F"},
+				{"A", "C", "D", "F"},
+			},
+		},
+		"zero-weight cycle": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 0}},
+				{Source: "C", Target: "B", Properties: EdgeProperties{Weight: 0}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 1}},
+			},
+			source: "A",
+			target: "D",
+			expectedPaths: [][]string{
+				{"A", "B", "C", "D"},
+			},
+		},
+		"source equals target": {
+			traits:        []func(*Traits){},
+			vertices:      []string{"A", "B"},
+			edges:         []Edge[string]{{Source: "A", Target: "B"}},
+			source:        "A",
+			target:        "A",
+			expectedPaths: [][]string{{"A"}},
+		},
+		"unreachable target": {
+			traits:      []func(*Traits){Directed()},
+			vertices:    []string{"A", "B"},
+			edges:       []Edge[string]{{Source: "B", Target: "A"}},
+			source:      "A",
+			target:      "B",
+			expectedErr: ErrTargetNotReachable,
+		},
+		"non-existent source": {
+			traits:      []func(*Traits){},
+			vertices:    []string{"A"},
+			source:      "X",
+			target:      "A",
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			paths, err := AllShortestPaths(g, test.source, test.target)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if len(paths) != len(test.expectedPaths) {
+				t.Fatalf("expected %d paths %v, got %d paths %v", len(test.expectedPaths), test.expectedPaths, len(paths), paths)
+			}
+
+			for _, expectedPath := range test.expectedPaths {
+				if !containsPath(paths, expectedPath) {
+					t.Errorf("expected path %v to be contained in %v", expectedPath, paths)
+				}
+			}
+		})
+	}
+}
+
+func TestFundamentalCycles(t *testing.T) {
+	tests := map[string]struct {
+		traits         []func(*Traits)
+		vertices       []int
+		edges          []Edge[int]
+		expectedCycles int
+	}{
+		"cyclomatic number 2": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 3},
+			},
+			expectedCycles: 2,
+		},
+		"tree": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expectedCycles: 0,
+		},
+		"directed graph with two components": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 5},
+			},
+			expectedCycles: 2,
+		},
+		"self-loop": {
+			traits:   []func(*Traits){AllowSelfLoops()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			expectedCycles: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			cycles, err := FundamentalCycles(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(cycles) != test.expectedCycles {
+				t.Fatalf("expected %d cycles, got %d: %v", test.expectedCycles, len(cycles), cycles)
+			}
+
+			for _, cycle := range cycles {
+				if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] {
+					t.Errorf("expected cycle %v to be closed", cycle)
+					continue
+				}
+
+				visited := make(map[int]struct{})
+				for i := 1; i < len(cycle); i++ {
+					if _, ok := visited[cycle[i]]; ok {
+						t.Errorf("expected cycle %v to be simple", cycle)
+					}
+					visited[cycle[i]] = struct{}{}
+
+					_, err := g.Edge(cycle[i-1], cycle[i])
+					if err != nil && g.Traits().IsDirected {
+						_, err = g.Edge(cycle[i], cycle[i-1])
+					}
+					if err != nil {
+						t.Errorf("expected vertices %v and %v of cycle %v to be adjacent", cycle[i-1], cycle[i], cycle)
+					}
+				}
+			}
 		})
 	}
 }
@@ -0,0 +1,128 @@
+package graph
+
+import "reflect"
+
+// GraphRead is the read-only subset of [Graph]. It provides access to the
+// vertices, edges, and traits of a graph, but doesn't allow to modify it. Each
+// Graph also is a GraphRead. For documentation on the individual methods, see
+// the respective methods of Graph.
+type GraphRead[K comparable, T any] interface {
+	Traits() *Traits
+	Vertex(hash K) (T, error)
+	VertexWithProperties(hash K) (T, VertexProperties, error)
+	Edge(sourceHash, targetHash K) (Edge[T], error)
+	Edges() ([]Edge[K], error)
+	AdjacencyMap() (map[K]map[K]Edge[K], error)
+	PredecessorMap() (map[K]map[K]Edge[K], error)
+	Clone() (Graph[K, T], error)
+	Order() (int, error)
+	Size() (int, error)
+}
+
+// ReadOnly returns a read-only view of the given graph. The view reflects all
+// changes made to the graph, but doesn't expose any methods for modifying it,
+// and it can't be type-asserted back to a Graph either.
+//
+// The traits, the attribute maps of vertices and edges, and edge data that is a
+// map are returned as copies, so changing them doesn't affect the graph. Clone
+// still returns a regular graph, which is an independent copy that may be
+// modified.
+func ReadOnly[K comparable, T any](g Graph[K, T]) GraphRead[K, T] {
+	return &readOnly[K, T]{
+		g: g,
+	}
+}
+
+type readOnly[K comparable, T any] struct {
+	g Graph[K, T]
+}
+
+func (r *readOnly[K, T]) Traits() *Traits {
+	traits := *r.g.Traits()
+	return &traits
+}
+
+func (r *readOnly[K, T]) Vertex(hash K) (T, error) {
+	return r.g.Vertex(hash)
+}
+
+func (r *readOnly[K, T]) VertexWithProperties(hash K) (T, VertexProperties, error) {
+	vertex, properties, err := r.g.VertexWithProperties(hash)
+	properties.Attributes = copyAttributes(properties.Attributes)
+
+	return vertex, properties, err
+}
+
+func (r *readOnly[K, T]) Edge(sourceHash, targetHash K) (Edge[T], error) {
+	edge, err := r.g.Edge(sourceHash, targetHash)
+	edge.Properties = copyEdgeProperties(edge.Properties)
+
+	return edge, err
+}
+
+func (r *readOnly[K, T]) Edges() ([]Edge[K], error) {
+	edges, err := r.g.Edges()
+
+	for i := range edges {
+		edges[i].Properties = copyEdgeProperties(edges[i].Properties)
+	}
+
+	return edges, err
+}
+
+func (r *readOnly[K, T]) AdjacencyMap() (map[K]map[K]Edge[K], error) {
+	adjacencyMap, err := r.g.AdjacencyMap()
+	copyEdgeMapProperties(adjacencyMap)
+
+	return adjacencyMap, err
+}
+
+func (r *readOnly[K, T]) PredecessorMap() (map[K]map[K]Edge[K], error) {
+	predecessorMap, err := r.g.PredecessorMap()
+	copyEdgeMapProperties(predecessorMap)
+
+	return predecessorMap, err
+}
+
+func (r *readOnly[K, T]) Clone() (Graph[K, T], error) {
+	return r.g.Clone()
+}
+
+func (r *readOnly[K, T]) Order() (int, error) {
+	return r.g.Order()
+}
+
+func (r *readOnly[K, T]) Size() (int, error) {
+	return r.g.Size()
+}
+
+// copyEdgeMapProperties replaces the properties of all edges in the given
+// adjacency or predecessor map with copies.
+func copyEdgeMapProperties[K comparable](m map[K]map[K]Edge[K]) {
+	for _, edges := range m {
+		for adjacency, edge := range edges {
+			edge.Properties = copyEdgeProperties(edge.Properties)
+			edges[adjacency] = edge
+		}
+	}
+}
+
+// copyEdgeProperties returns a copy of the given edge properties that doesn't
+// share its attributes with the original properties. If the edge data is a map,
+// it is copied as well.
+func copyEdgeProperties(properties EdgeProperties) EdgeProperties {
+	properties.Attributes = copyAttributes(properties.Attributes)
+
+	if data := reflect.ValueOf(properties.Data); data.Kind() == reflect.Map && !data.IsNil() {
+		copied := reflect.MakeMapWithSize(data.Type(), data.Len())
+
+		iter := data.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), iter.Value())
+		}
+
+		properties.Data = copied.Interface()
+	}
+
+	return properties
+}
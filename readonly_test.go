@@ -0,0 +1,107 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestReadOnly(t *testing.T) {
+	tests := map[string]struct {
+		traits []func(*Traits)
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed()},
+		},
+		"undirected graph": {
+			traits: []func(*Traits){},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			_ = g.AddVertex(1)
+			_ = g.AddVertex(2)
+			_ = g.AddEdge(1, 2)
+
+			view := ReadOnly(g)
+
+			if _, ok := view.(Graph[int, int]); ok {
+				t.Errorf("expected read-only view not to be a Graph")
+			}
+
+			if _, ok := view.(interface {
+				AddVertex(value int, options ...func(*VertexProperties)) error
+			}); ok {
+				t.Errorf("expected read-only view not to expose AddVertex")
+			}
+
+			if !traitsAreEqual(g.Traits(), view.Traits()) {
+				t.Errorf("expected traits %v, got %v", g.Traits(), view.Traits())
+			}
+
+			view.Traits().IsDirected = !view.Traits().IsDirected
+
+			if !traitsAreEqual(g.Traits(), view.Traits()) {
+				t.Errorf("expected traits of the view not to be modifiable")
+			}
+
+			// The view reflects changes made to the underlying graph.
+			_ = g.AddVertex(3)
+			_ = g.AddEdge(2, 3)
+
+			if order, _ := view.Order(); order != 3 {
+				t.Errorf("expected order 3, got %d", order)
+			}
+
+			if size, _ := view.Size(); size != 2 {
+				t.Errorf("expected size 2, got %d", size)
+			}
+
+			if _, err := view.Edge(2, 3); err != nil {
+				t.Errorf("expected edge (2, 3) to exist: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestReadOnly_copiesProperties(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1, VertexAttribute("color", "red"))
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2, EdgeAttribute("color", "red"), EdgeData(map[string]int{"capacity": 1}))
+
+	view := ReadOnly(g)
+
+	_, properties, _ := view.VertexWithProperties(1)
+	properties.Attributes["color"] = "blue"
+
+	edge, _ := view.Edge(1, 2)
+	edge.Properties.Attributes["color"] = "blue"
+	edge.Properties.Data.(map[string]int)["capacity"] = 2
+
+	edges, _ := view.Edges()
+	edges[0].Properties.Attributes["color"] = "blue"
+
+	adjacencyMap, _ := view.AdjacencyMap()
+	adjacencyMap[1][2].Properties.Attributes["color"] = "blue"
+	adjacencyMap[1][2].Properties.Data.(map[string]int)["capacity"] = 2
+
+	predecessorMap, _ := view.PredecessorMap()
+	predecessorMap[2][1].Properties.Attributes["color"] = "blue"
+
+	_, properties, _ = g.VertexWithProperties(1)
+	if properties.Attributes["color"] != "red" {
+		t.Errorf("expected vertex color red, got %v", properties.Attributes["color"])
+	}
+
+	edge, _ = g.Edge(1, 2)
+	if edge.Properties.Attributes["color"] != "red" {
+		t.Errorf("expected edge color red, got %v", edge.Properties.Attributes["color"])
+	}
+
+	if capacity := edge.Properties.Data.(map[string]int)["capacity"]; capacity != 1 {
+		t.Errorf("expected edge capacity 1, got %v", capacity)
+	}
+}
@@ -0,0 +1,74 @@
+package graph
+
+import "fmt"
+
+// IsSymmetric determines whether the edge relation of the given graph is
+// symmetric, i.e. whether for every edge (A,B) there also is an edge (B,A).
+// Undirected graphs are always symmetric.
+func IsSymmetric[K comparable, T any](g Graph[K, T]) (bool, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			if _, ok := adjacencyMap[target][source]; !ok {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// IsAntisymmetric determines whether the edge relation of the given graph is
+// antisymmetric, i.e. whether there are no two distinct vertices A and B such
+// that there is both an edge (A,B) and an edge (B,A). Self-loops are allowed.
+//
+// An undirected graph is only antisymmetric if it has no edges other than
+// self-loops.
+func IsAntisymmetric[K comparable, T any](g Graph[K, T]) (bool, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			if source == target {
+				continue
+			}
+			if _, ok := adjacencyMap[target][source]; ok {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// IsTransitive determines whether the edge relation of the given graph is
+// transitive, i.e. whether for every two edges (A,B) and (B,C) there also is
+// an edge (A,C). Note that for A = C, this requires a self-loop (A,A).
+//
+// IsTransitive checks all pairs of adjacent edges and thus scales with O(|V|^3)
+// in the worst case.
+func IsTransitive[K comparable, T any](g Graph[K, T]) (bool, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for a, adjacencies := range adjacencyMap {
+		for b := range adjacencies {
+			for c := range adjacencyMap[b] {
+				if _, ok := adjacencyMap[a][c]; !ok {
+					return false, nil
+				}
+			}
+		}
+	}
+
+	return true, nil
+}
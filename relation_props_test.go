@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestRelationProperties(t *testing.T) {
+	tests := map[string]struct {
+		traits                []func(*Traits)
+		vertices              []int
+		edges                 []Edge[int]
+		expectedSymmetric     bool
+		expectedAntisymmetric bool
+		expectedTransitive    bool
+	}{
+		"symmetric directed graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 2},
+			},
+			expectedSymmetric:     true,
+			expectedAntisymmetric: false,
+			expectedTransitive:    false,
+		},
+		"antisymmetric and transitive directed graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+			expectedSymmetric:     false,
+			expectedAntisymmetric: true,
+			expectedTransitive:    true,
+		},
+		"transitive directed graph with self-loops": {
+			traits:   []func(*Traits){Directed(), AllowSelfLoops()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 2},
+			},
+			expectedSymmetric:     true,
+			expectedAntisymmetric: false,
+			expectedTransitive:    true,
+		},
+		"directed path": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedSymmetric:     false,
+			expectedAntisymmetric: true,
+			expectedTransitive:    false,
+		},
+		"undirected graph": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedSymmetric:     true,
+			expectedAntisymmetric: false,
+			expectedTransitive:    false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			symmetric, _ := IsSymmetric(g)
+			antisymmetric, _ := IsAntisymmetric(g)
+			transitive, _ := IsTransitive(g)
+
+			if symmetric != test.expectedSymmetric {
+				t.Errorf("expected symmetric == %v, got %v", test.expectedSymmetric, symmetric)
+			}
+
+			if antisymmetric != test.expectedAntisymmetric {
+				t.Errorf("expected antisymmetric == %v, got %v", test.expectedAntisymmetric, antisymmetric)
+			}
+
+			if transitive != test.expectedTransitive {
+				t.Errorf("expected transitive == %v, got %v", test.expectedTransitive, transitive)
+			}
+		})
+	}
+}
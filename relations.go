@@ -0,0 +1,101 @@
+package graph
+
+import "fmt"
+
+// EdgeKey identifies an edge by the hash values of its source and target
+// vertices. Since it is comparable, it can be used as a map key, e.g. for
+// keeping track of visited edges.
+type EdgeKey[K comparable] struct {
+	Source, Target K
+}
+
+// UndirectedEdges returns all edges of the graph, yielding each edge joining two
+// vertices exactly once. For an undirected graph, an edge (A,B) is the same as
+// (B,A), so only one of them is returned. For directed graphs, (A,B) and (B,A)
+// are different edges and both are returned.
+func UndirectedEdges[K comparable, T any](g Graph[K, T]) ([]Edge[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges := make([]Edge[K], 0)
+	seen := make(map[EdgeKey[K]]struct{})
+
+	for _, adjacencies := range adjacencyMap {
+		for _, edge := range adjacencies {
+			if !g.Traits().IsDirected {
+				if _, ok := seen[EdgeKey[K]{Source: edge.Target, Target: edge.Source}]; ok {
+					continue
+				}
+			}
+
+			key := EdgeKey[K]{Source: edge.Source, Target: edge.Target}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			edges = append(edges, edge)
+		}
+	}
+
+	return edges, nil
+}
+
+// EdgeWeights looks up the weights of the edges joining the given pairs of
+// vertices. Instead of calling Edge for each pair, EdgeWeights obtains the
+// adjacency map once and resolves all pairs from it, which is considerably
+// faster for many lookups.
+//
+// The returned map contains the weight of each edge that exists. Pairs whose
+// vertices aren't joined by an edge are not contained in the map. For an
+// undirected graph, the order of the vertices within a pair doesn't matter.
+func EdgeWeights[K comparable, T any](g Graph[K, T], pairs []EdgeKey[K]) (map[EdgeKey[K]]float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	weights := make(map[EdgeKey[K]]float64, len(pairs))
+
+	for _, pair := range pairs {
+		edge, ok := adjacencyMap[pair.Source][pair.Target]
+		if !ok {
+			continue
+		}
+		weights[pair] = float64(edge.Properties.Weight)
+	}
+
+	return weights, nil
+}
+
+// AdjacencyMapsEqual determines whether the given adjacency maps contain the
+// same vertices and the same adjacencies for each of those vertices. The
+// edgeEqual function decides whether the edges of a shared adjacency are equal.
+// If it is nil, only the presence of the adjacencies is compared.
+func AdjacencyMapsEqual[K comparable](a, b map[K]map[K]Edge[K], edgeEqual func(a, b Edge[K]) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for vertex, aAdjacencies := range a {
+		bAdjacencies, ok := b[vertex]
+		if !ok || len(aAdjacencies) != len(bAdjacencies) {
+			return false
+		}
+
+		for adjacency, aEdge := range aAdjacencies {
+			bEdge, ok := bAdjacencies[adjacency]
+			if !ok {
+				return false
+			}
+
+			if edgeEqual != nil && !edgeEqual(aEdge, bEdge) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
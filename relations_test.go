@@ -0,0 +1,271 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestUndirectedEdges(t *testing.T) {
+	tests := map[string]struct {
+		traits   []func(*Traits)
+		vertices []int
+		edges    []Edge[int]
+	}{
+		"undirected graph": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+			},
+		},
+		"undirected graph with self-loop": {
+			traits:   []func(*Traits){AllowSelfLoops()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+		},
+		"directed graph with antiparallel edges": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			edges, err := UndirectedEdges(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			size, _ := g.Size()
+
+			if len(edges) != size {
+				t.Errorf("expected %d edges, got %d", size, len(edges))
+			}
+
+			for _, expected := range test.edges {
+				found := false
+				for _, edge := range edges {
+					if edgesAreEqual(expected, edge, g.Traits().IsDirected) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected edge %v to be yielded", expected)
+				}
+			}
+		})
+	}
+}
+
+func TestEdgeWeights(t *testing.T) {
+	tests := map[string]struct {
+		traits          []func(*Traits)
+		edges           []Edge[int]
+		pairs           []EdgeKey[int]
+		expectedWeights map[EdgeKey[int]]float64
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+			},
+			pairs: []EdgeKey[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expectedWeights: map[EdgeKey[int]]float64{
+				{Source: 1, Target: 2}: 3,
+				{Source: 2, Target: 3}: 5,
+			},
+		},
+		"undirected graph": {
+			traits: []func(*Traits){Weighted()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+			},
+			pairs: []EdgeKey[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 1, Target: 3},
+			},
+			expectedWeights: map[EdgeKey[int]]float64{
+				{Source: 1, Target: 2}: 3,
+				{Source: 2, Target: 1}: 3,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range []int{1, 2, 3, 4} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			weights, err := EdgeWeights(g, test.pairs)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if len(weights) != len(test.expectedWeights) {
+				t.Errorf("expected weights %v, got %v", test.expectedWeights, weights)
+			}
+
+			for _, pair := range test.pairs {
+				weight, ok := weights[pair]
+				edge, err := g.Edge(pair.Source, pair.Target)
+
+				if ok != (err == nil) {
+					t.Errorf("expected pair %v to be contained == %v, got %v", pair, err == nil, ok)
+					continue
+				}
+
+				if ok && weight != float64(edge.Properties.Weight) {
+					t.Errorf("expected weight %v for %v, got %v", edge.Properties.Weight, pair, weight)
+				}
+
+				if expected := test.expectedWeights[pair]; ok && weight != expected {
+					t.Errorf("expected weight %v for %v, got %v", expected, pair, weight)
+				}
+			}
+		})
+	}
+}
+
+func TestAdjacencyMapsEqual(t *testing.T) {
+	weightsAreEqual := func(a, b Edge[int]) bool {
+		return a.Properties.Weight == b.Properties.Weight
+	}
+
+	tests := map[string]struct {
+		edgesA    []Edge[int]
+		edgesB    []Edge[int]
+		edgeEqual func(a, b Edge[int]) bool
+		expected  bool
+	}{
+		"equal maps built in different order": {
+			edgesA: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 4}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 5}},
+			},
+			edgesB: []Edge[int]{
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 5}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 4}},
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+			},
+			edgeEqual: weightsAreEqual,
+			expected:  true,
+		},
+		"different edge weight": {
+			edgesA: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+			},
+			edgesB: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+			},
+			edgeEqual: weightsAreEqual,
+			expected:  false,
+		},
+		"different edge weight without edgeEqual": {
+			edgesA: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+			},
+			edgesB: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+			},
+			expected: true,
+		},
+		"additional edge": {
+			edgesA: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			edgesB: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expected: false,
+		},
+		"different edge": {
+			edgesA: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			edgesB: []Edge[int]{
+				{Source: 2, Target: 1},
+			},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := New(IntHash, Directed(), Weighted())
+			b := New(IntHash, Directed(), Weighted())
+
+			for _, vertex := range []int{1, 2, 3} {
+				_ = a.AddVertex(vertex)
+				_ = b.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edgesA {
+				if err := a.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			for _, edge := range test.edgesB {
+				if err := b.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			adjacencyMapA, _ := a.AdjacencyMap()
+			adjacencyMapB, _ := b.AdjacencyMap()
+
+			if equal := AdjacencyMapsEqual(adjacencyMapA, adjacencyMapB, test.edgeEqual); equal != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, equal)
+			}
+
+			if equal := AdjacencyMapsEqual(adjacencyMapB, adjacencyMapA, test.edgeEqual); equal != test.expected {
+				t.Errorf("expected %v for swapped maps, got %v", test.expected, equal)
+			}
+		})
+	}
+
+	missingVertex := map[int]map[int]Edge[int]{1: {}}
+	if AdjacencyMapsEqual(missingVertex, map[int]map[int]Edge[int]{1: {}, 2: {}}, nil) {
+		t.Errorf("expected maps with different vertices to be unequal")
+	}
+}
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -59,6 +60,56 @@ func Union[K comparable, T any](g, h Graph[K, T]) (Graph[K, T], error) {
 	return union, nil
 }
 
+// ReconcileEdges adds all edges of h to g. Instead of failing for edges that
+// already exist in g, it invokes the given resolve function with the existing
+// and the incoming edge and updates the existing edge with the properties it
+// returns. For undirected graphs, an incoming edge (B,A) resolves against an
+// existing edge (A,B). All vertices joined by the edges of h have to exist in g.
+//
+// Unlike AddEdgesFrom, ReconcileEdges is not atomic: If adding or updating an
+// edge fails, the edges processed up to this point remain in g.
+func ReconcileEdges[K comparable, T any](g, h Graph[K, T], resolve func(existing, incoming Edge[K]) EdgeProperties) error {
+	edges, err := UndirectedEdges(h)
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	for _, incoming := range edges {
+		existing, err := g.Edge(incoming.Source, incoming.Target)
+
+		if errors.Is(err, ErrEdgeNotFound) {
+			if err := g.AddEdge(copyEdge(incoming)); err != nil {
+				return fmt.Errorf("failed to add edge (%v, %v): %w", incoming.Source, incoming.Target, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get edge (%v, %v): %w", incoming.Source, incoming.Target, err)
+		}
+
+		resolved := resolve(Edge[K]{
+			Source:     incoming.Source,
+			Target:     incoming.Target,
+			Properties: existing.Properties,
+		}, incoming)
+
+		err = g.UpdateEdge(incoming.Source, incoming.Target, func(properties *EdgeProperties) {
+			properties.Attributes = make(map[string]string, len(resolved.Attributes))
+			for key, value := range resolved.Attributes {
+				properties.Attributes[key] = value
+			}
+			properties.Weight = resolved.Weight
+			properties.Data = resolved.Data
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update edge (%v, %v): %w", incoming.Source, incoming.Target, err)
+		}
+	}
+
+	return nil
+}
+
 // unionFind implements a union-find or disjoint set data structure that works
 // with vertex hashes as vertices. It's an internal helper type at the moment,
 // but could perhaps be exposed publicly in the future.
@@ -106,8 +157,8 @@ func (u *unionFind[K]) find(vertex K) K {
 	current := vertex
 
 	for u.parents[current] != root {
-		parent := u.parents[vertex]
-		u.parents[vertex] = root
+		parent := u.parents[current]
+		u.parents[current] = root
 		current = parent
 	}
 
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -165,6 +166,124 @@ func TestDirectedUnion(t *testing.T) {
 	}
 }
 
+func TestReconcileEdges(t *testing.T) {
+	minWeight := func(existing, incoming Edge[int]) EdgeProperties {
+		properties := existing.Properties
+		if incoming.Properties.Weight < properties.Weight {
+			properties.Weight = incoming.Properties.Weight
+		}
+		properties.Attributes["source"] = "reconciled"
+		return properties
+	}
+
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		gEdges        []Edge[int]
+		hEdges        []Edge[int]
+		expectedEdges []Edge[int]
+	}{
+		"directed graphs sharing an edge": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			gEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+			},
+			hEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 4}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 7}},
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3, Attributes: map[string]string{"source": "reconciled"}}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1, Attributes: map[string]string{"source": "reconciled"}}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 7, Attributes: map[string]string{}}},
+			},
+		},
+		"undirected graphs sharing a reversed edge": {
+			traits: []func(*Traits){Weighted()},
+			gEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+			},
+			hEdges: []Edge[int]{
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 2}},
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 2, Attributes: map[string]string{"source": "reconciled"}}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 2, Attributes: map[string]string{"source": "reconciled"}}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+			h := New(IntHash, test.traits...)
+
+			for _, vertex := range []int{1, 2, 3} {
+				_ = g.AddVertex(vertex)
+				_ = h.AddVertex(vertex)
+			}
+
+			for _, edge := range test.gEdges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			for _, edge := range test.hEdges {
+				if err := h.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			if err := ReconcileEdges(g, h, minWeight); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			for _, expectedEdge := range test.expectedEdges {
+				edge, err := g.Edge(expectedEdge.Source, expectedEdge.Target)
+				if err != nil {
+					t.Fatalf("expected edge (%v, %v): %s", expectedEdge.Source, expectedEdge.Target, err.Error())
+				}
+
+				if edge.Properties.Weight != expectedEdge.Properties.Weight {
+					t.Errorf("expected weight %d for edge (%v, %v), got %d", expectedEdge.Properties.Weight, expectedEdge.Source, expectedEdge.Target, edge.Properties.Weight)
+				}
+
+				if len(edge.Properties.Attributes) != len(expectedEdge.Properties.Attributes) {
+					t.Errorf("expected attributes %v for edge (%v, %v), got %v", expectedEdge.Properties.Attributes, expectedEdge.Source, expectedEdge.Target, edge.Properties.Attributes)
+				}
+			}
+
+			// The edges of h must not have been modified by the resolver.
+			for _, edge := range test.hEdges {
+				hEdge, _ := h.Edge(edge.Source, edge.Target)
+				if len(hEdge.Properties.Attributes) != 0 {
+					t.Errorf("expected edges of h to remain unchanged, got %v", hEdge.Properties.Attributes)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileEdges_missingVertex(t *testing.T) {
+	g := New(IntHash)
+	h := New(IntHash)
+
+	_ = g.AddVertex(1)
+	_ = h.AddVertex(1)
+	_ = h.AddVertex(2)
+	_ = h.AddEdge(1, 2)
+
+	err := ReconcileEdges(g, h, func(existing, _ Edge[int]) EdgeProperties {
+		return existing.Properties
+	})
+
+	if !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+}
+
 func TestUnionFind_add(t *testing.T) {
 	tests := map[string]struct {
 		vertex         int
@@ -285,46 +404,43 @@ func TestUnionFind_find(t *testing.T) {
 	}
 }
 
+func TestUnionFind_findPathCompression(t *testing.T) {
+	// Build a long chain 10 -> 9 -> ... -> 1 where 1 is the root.
+	u := newUnionFind[int](1)
+	for i := 2; i <= 10; i++ {
+		u.parents[i] = i - 1
+	}
+
+	if root := u.find(10); root != 1 {
+		t.Fatalf("expected root 1, got %v", root)
+	}
+
+	for i := 1; i <= 10; i++ {
+		if parent := u.parents[i]; parent != 1 {
+			t.Errorf("expected %v to point to root 1 after path compression, got %v", i, parent)
+		}
+	}
+}
+
 func adjacencyMapsAreEqual[K comparable](a, b map[K]map[K]Edge[K], edgesAreEqual func(a, b Edge[K]) bool) bool {
-	for aHash, aAdjacencies := range a {
-		bAdjacencies, ok := b[aHash]
-		if !ok {
+	return AdjacencyMapsEqual(a, b, func(aEdge, bEdge Edge[K]) bool {
+		if !edgesAreEqual(aEdge, bEdge) {
 			return false
 		}
 
-		for aAdjacency, aEdge := range aAdjacencies {
-			bEdge, ok := bAdjacencies[aAdjacency]
-			if !ok {
-				return false
-			}
-
-			if !edgesAreEqual(aEdge, bEdge) {
-				return false
-			}
-
-			for aKey, aValue := range aEdge.Properties.Attributes {
-				bValue, ok := bEdge.Properties.Attributes[aKey]
-				if !ok {
-					return false
-				}
-				if bValue != aValue {
-					return false
-				}
-			}
+		if len(aEdge.Properties.Attributes) != len(bEdge.Properties.Attributes) {
+			return false
+		}
 
-			if bEdge.Properties.Weight != aEdge.Properties.Weight {
+		for aKey, aValue := range aEdge.Properties.Attributes {
+			bValue, ok := bEdge.Properties.Attributes[aKey]
+			if !ok || bValue != aValue {
 				return false
 			}
 		}
-	}
-
-	for aHash := range a {
-		if _, ok := b[aHash]; !ok {
-			return false
-		}
-	}
 
-	return true
+		return aEdge.Properties.Weight == bEdge.Properties.Weight
+	})
 }
 
 func mapsAreEqual[K comparable](a, b map[K]K) bool {
@@ -73,6 +73,13 @@ type memoryStore[K comparable, T any] struct {
 	// these edges themselves are stored in maps whose keys are the hashes of the target vertices.
 	outEdges map[K]map[K]Edge[K] // source -> target
 	inEdges  map[K]map[K]Edge[K] // target -> source
+
+	// adjacencyCache and predecessorCache are read-only copies of outEdges and inEdges that are
+	// built on demand and dropped by all mutating methods. cacheLock guards building them while
+	// only the read lock is held.
+	cacheLock        sync.Mutex
+	adjacencyCache   map[K]map[K]Edge[K]
+	predecessorCache map[K]map[K]Edge[K]
 }
 
 func newMemoryStore[K comparable, T any]() Store[K, T] {
@@ -88,6 +95,8 @@ func (s *memoryStore[K, T]) AddVertex(k K, t T, p VertexProperties) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.invalidateCacheWithLock()
+
 	if existing, ok := s.vertices[k]; ok {
 		return &VertexAlreadyExistsError[K, T]{
 			Key:           k,
@@ -140,13 +149,18 @@ func (s *memoryStore[K, T]) vertexWithLock(k K) (T, VertexProperties, error) {
 }
 
 func (s *memoryStore[K, T]) RemoveVertex(k K) error {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.invalidateCacheWithLock()
 
 	if _, ok := s.vertices[k]; !ok {
 		return &VertexNotFoundError[K]{Key: k}
 	}
 
+	// Both the ingoing and the outgoing edges need to be checked: A vertex that
+	// only is the target of an edge doesn't have any outgoing edges. This also
+	// applies to undirected graphs if the edge has been stored just once.
 	if edges, ok := s.inEdges[k]; ok {
 		if count := len(edges); count > 0 {
 			return &VertexHasEdgesError[K]{Key: k, Count: count}
@@ -171,6 +185,8 @@ func (s *memoryStore[K, T]) AddEdge(sourceHash, targetHash K, edge Edge[K]) erro
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.invalidateCacheWithLock()
+
 	if _, _, err := s.vertexWithLock(sourceHash); err != nil {
 		return fmt.Errorf("could not get source vertex: %w", &VertexNotFoundError[K]{Key: sourceHash})
 	}
@@ -206,6 +222,8 @@ func (s *memoryStore[K, T]) UpdateEdge(sourceHash, targetHash K, edge Edge[K]) e
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.invalidateCacheWithLock()
+
 	if _, err := s.edgeWithLock(sourceHash, targetHash); err != nil {
 		return err
 	}
@@ -220,6 +238,8 @@ func (s *memoryStore[K, T]) RemoveEdge(sourceHash, targetHash K) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.invalidateCacheWithLock()
+
 	delete(s.inEdges[targetHash], sourceHash)
 	delete(s.outEdges[sourceHash], targetHash)
 	return nil
@@ -305,3 +325,217 @@ func (s *memoryStore[K, T]) CreatesCycle(source, target K) (bool, error) {
 
 	return false, nil
 }
+
+// AdjacencyMap is a fastpath version of Graph.AdjacencyMap that builds the adjacency map directly
+// from outEdges instead of listing all vertices and edges first.
+//
+// Callers are free to modify the returned map, so AdjacencyMap always returns a new copy. Library
+// algorithms that only read the map use CachedAdjacencyMap instead.
+func (s *memoryStore[K, T]) AdjacencyMap() (map[K]map[K]Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.copyEdgesWithLock(s.outEdges), nil
+}
+
+// PredecessorMap is a fastpath version of Graph.PredecessorMap that builds the predecessor map
+// directly from inEdges, just like AdjacencyMap does for outEdges.
+func (s *memoryStore[K, T]) PredecessorMap() (map[K]map[K]Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.copyEdgesWithLock(s.inEdges), nil
+}
+
+// copyEdgesWithLock copies the given edge maps, adding an empty map for each vertex without
+// edges. The caller must be holding at least a read-level lock.
+func (s *memoryStore[K, T]) copyEdgesWithLock(edges map[K]map[K]Edge[K]) map[K]map[K]Edge[K] {
+	m := make(map[K]map[K]Edge[K], len(s.vertices))
+
+	for vertex := range s.vertices {
+		vertexEdges := edges[vertex]
+		m[vertex] = make(map[K]Edge[K], len(vertexEdges))
+
+		for adjacency, edge := range vertexEdges {
+			m[vertex][adjacency] = edge
+		}
+	}
+
+	return m
+}
+
+// CachedAdjacencyMap is a fastpath for library algorithms that only read the adjacency map. It
+// returns a copy of outEdges that is built once and reused until the store is modified, so that
+// repeated calls don't allocate. The returned map is shared and must not be modified.
+func (s *memoryStore[K, T]) CachedAdjacencyMap() (map[K]map[K]Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	s.cacheLock.Lock()
+	defer s.cacheLock.Unlock()
+
+	if s.adjacencyCache == nil {
+		s.adjacencyCache = s.copyEdgesWithLock(s.outEdges)
+	}
+
+	return s.adjacencyCache, nil
+}
+
+// CachedPredecessorMap is the equivalent of CachedAdjacencyMap for inEdges. The returned map is
+// shared and must not be modified.
+func (s *memoryStore[K, T]) CachedPredecessorMap() (map[K]map[K]Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	s.cacheLock.Lock()
+	defer s.cacheLock.Unlock()
+
+	if s.predecessorCache == nil {
+		s.predecessorCache = s.copyEdgesWithLock(s.inEdges)
+	}
+
+	return s.predecessorCache, nil
+}
+
+// invalidateCacheWithLock drops the cached adjacency and predecessor maps. The caller must be
+// holding the write lock, which ensures that no cached map is being built concurrently.
+func (s *memoryStore[K, T]) invalidateCacheWithLock() {
+	s.adjacencyCache = nil
+	s.predecessorCache = nil
+}
+
+// ShortestPaths is a fastpath version of Dijkstra's algorithm that iterates over outEdges directly
+// instead of calling [AdjacencyMap], which copies every edge into a new map.
+//
+// It returns the distances of all vertices reachable from the source along with the cheapest
+// predecessor of each reachable vertex except for the source. The read lock is held for the
+// entire computation.
+func (s *memoryStore[K, T]) ShortestPaths(source K, edgeWeight func(Edge[K]) float64) (map[K]float64, map[K]K, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	weights, bestPredecessors := runDijkstra(source, func(vertex K) map[K]Edge[K] {
+		return s.outEdges[vertex]
+	}, edgeWeight, nil)
+
+	return weights, bestPredecessors, nil
+}
+
+// Snapshot creates an independent copy of the store. All vertices and edges are copied under a
+// single read lock, so the copy reflects a consistent state of the store.
+func (s *memoryStore[K, T]) Snapshot() (Store[K, T], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	snapshot := &memoryStore[K, T]{
+		vertices:         make(map[K]T, len(s.vertices)),
+		vertexProperties: make(map[K]VertexProperties, len(s.vertexProperties)),
+		outEdges:         make(map[K]map[K]Edge[K], len(s.outEdges)),
+		inEdges:          make(map[K]map[K]Edge[K], len(s.inEdges)),
+	}
+
+	for hash, vertex := range s.vertices {
+		snapshot.vertices[hash] = vertex
+	}
+
+	for hash, properties := range s.vertexProperties {
+		snapshot.vertexProperties[hash] = VertexProperties{
+			Attributes: copyAttributes(properties.Attributes),
+			Weight:     properties.Weight,
+		}
+	}
+
+	for hash := range s.inEdges {
+		snapshot.inEdges[hash] = make(map[K]Edge[K], len(s.inEdges[hash]))
+	}
+
+	// An edge is stored both in outEdges and in inEdges. The copied edge is
+	// stored in both maps as well, so that they share the copied attributes.
+	for source, edges := range s.outEdges {
+		snapshot.outEdges[source] = make(map[K]Edge[K], len(edges))
+
+		for target, edge := range edges {
+			edge.Properties.Attributes = copyAttributes(edge.Properties.Attributes)
+			snapshot.outEdges[source][target] = edge
+
+			if _, ok := snapshot.inEdges[target]; !ok {
+				snapshot.inEdges[target] = make(map[K]Edge[K])
+			}
+			snapshot.inEdges[target][source] = edge
+		}
+	}
+
+	return snapshot, nil
+}
+
+func copyAttributes(attributes map[string]string) map[string]string {
+	if attributes == nil {
+		return nil
+	}
+
+	copied := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		copied[key] = value
+	}
+
+	return copied
+}
+
+// Degrees is a fastpath for computing vertex degrees that obtains the number of outgoing and
+// ingoing edges of each vertex from the lengths of outEdges and inEdges, without building an
+// adjacency map. A self-loop counts towards both the out-degree and the in-degree of its vertex.
+func (s *memoryStore[K, T]) Degrees() (map[K]int, map[K]int, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	outDegrees := make(map[K]int, len(s.vertices))
+	inDegrees := make(map[K]int, len(s.vertices))
+
+	for vertex := range s.vertices {
+		outDegrees[vertex] = len(s.outEdges[vertex])
+		inDegrees[vertex] = len(s.inEdges[vertex])
+	}
+
+	return outDegrees, inDegrees, nil
+}
+
+// RemoveEdges is a fastpath for removing multiple edges that acquires the write lock only once
+// instead of once per edge. If ignoreMissing is false and one of the edges doesn't exist,
+// ErrEdgeNotFound is returned before any edge is removed.
+func (s *memoryStore[K, T]) RemoveEdges(edges []Edge[K], ignoreMissing bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.invalidateCacheWithLock()
+
+	if !ignoreMissing {
+		for _, edge := range edges {
+			if _, err := s.edgeWithLock(edge.Source, edge.Target); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, edge := range edges {
+		delete(s.inEdges[edge.Target], edge.Source)
+		delete(s.outEdges[edge.Source], edge.Target)
+	}
+
+	return nil
+}
+
+// Clear is a fastpath for removing all vertices and edges that resets the maps of the store under
+// a single write lock instead of removing each edge and vertex individually.
+func (s *memoryStore[K, T]) Clear() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.invalidateCacheWithLock()
+
+	s.vertices = make(map[K]T)
+	s.vertexProperties = make(map[K]VertexProperties)
+	s.outEdges = make(map[K]map[K]Edge[K])
+	s.inEdges = make(map[K]map[K]Edge[K])
+
+	return nil
+}
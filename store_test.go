@@ -2,6 +2,7 @@ package graph
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -48,6 +49,19 @@ func TestRemoveEdge(t *testing.T) {
 			t.Fail()
 		}
 	})
+	t.Run("remove undirected edge target stored once", func(t *testing.T) {
+		g := build([]string{
+			"a", "b",
+		}, [][]string{
+			{"a", "b"},
+		})
+		if err := g.RemoveVertex("b"); !errors.Is(err, ErrVertexHasEdges) {
+			t.Fail()
+		}
+		if _, _, err := g.Vertex("b"); err != nil {
+			t.Errorf("expected vertex to remain, got %v", err)
+		}
+	})
 	t.Run("remove edge has out-edges", func(t *testing.T) {
 		g := build([]string{
 			"a", "b", "c",
@@ -60,3 +74,246 @@ func TestRemoveEdge(t *testing.T) {
 		}
 	})
 }
+
+func TestMemoryStore_AdjacencyMap(t *testing.T) {
+	store := newMemoryStore[string, string]().(*memoryStore[string, string])
+
+	for _, vertex := range []string{"a", "b", "c"} {
+		_ = store.AddVertex(vertex, vertex, VertexProperties{})
+	}
+
+	_ = store.AddEdge("a", "b", Edge[string]{Source: "a", Target: "b"})
+	_ = store.AddEdge("a", "c", Edge[string]{Source: "a", Target: "c"})
+
+	adjacencyMap, _ := store.AdjacencyMap()
+	predecessorMap, _ := store.PredecessorMap()
+
+	expectedAdjacencyMap := map[string]map[string]Edge[string]{
+		"a": {
+			"b": {Source: "a", Target: "b"},
+			"c": {Source: "a", Target: "c"},
+		},
+		"b": {},
+		"c": {},
+	}
+
+	expectedPredecessorMap := map[string]map[string]Edge[string]{
+		"a": {},
+		"b": {"a": {Source: "a", Target: "b"}},
+		"c": {"a": {Source: "a", Target: "c"}},
+	}
+
+	edgesAreEqual := func(a, b Edge[string]) bool {
+		return a.Source == b.Source && a.Target == b.Target
+	}
+
+	if !adjacencyMapsAreEqual(expectedAdjacencyMap, adjacencyMap, edgesAreEqual) {
+		t.Errorf("expected adjacency map %v, got %v", expectedAdjacencyMap, adjacencyMap)
+	}
+
+	if !adjacencyMapsAreEqual(expectedPredecessorMap, predecessorMap, edgesAreEqual) {
+		t.Errorf("expected predecessor map %v, got %v", expectedPredecessorMap, predecessorMap)
+	}
+
+	// Modifying the returned map must not affect the store.
+	delete(adjacencyMap["a"], "b")
+	delete(predecessorMap, "c")
+
+	if _, err := store.Edge("a", "b"); err != nil {
+		t.Errorf("expected edge (a, b) to remain in store, got %v", err)
+	}
+
+	// Subsequent calls must reflect mutations of the store.
+	_ = store.RemoveEdge("a", "c")
+	_ = store.AddVertex("d", "d", VertexProperties{})
+
+	adjacencyMap, _ = store.AdjacencyMap()
+
+	if _, ok := adjacencyMap["a"]["c"]; ok {
+		t.Errorf("expected edge (a, c) to be removed from adjacency map")
+	}
+
+	if _, ok := adjacencyMap["d"]; !ok {
+		t.Errorf("expected vertex d to be contained in adjacency map")
+	}
+
+	if _, ok := adjacencyMap["a"]["b"]; !ok {
+		t.Errorf("expected edge (a, b) to be contained in adjacency map")
+	}
+}
+
+func TestMemoryStore_CachedAdjacencyMap(t *testing.T) {
+	tests := map[string]struct {
+		mutate        func(store *memoryStore[string, string]) error
+		expectedEdges []EdgeKey[string]
+		expectedOrder int
+	}{
+		"AddVertex": {
+			mutate: func(store *memoryStore[string, string]) error {
+				return store.AddVertex("d", "d", VertexProperties{})
+			},
+			expectedEdges: []EdgeKey[string]{{Source: "a", Target: "b"}, {Source: "b", Target: "c"}},
+			expectedOrder: 4,
+		},
+		"RemoveVertex": {
+			mutate: func(store *memoryStore[string, string]) error {
+				_ = store.RemoveEdge("b", "c")
+				return store.RemoveVertex("c")
+			},
+			expectedEdges: []EdgeKey[string]{{Source: "a", Target: "b"}},
+			expectedOrder: 2,
+		},
+		"AddEdge": {
+			mutate: func(store *memoryStore[string, string]) error {
+				return store.AddEdge("a", "c", Edge[string]{Source: "a", Target: "c"})
+			},
+			expectedEdges: []EdgeKey[string]{{Source: "a", Target: "b"}, {Source: "b", Target: "c"}, {Source: "a", Target: "c"}},
+			expectedOrder: 3,
+		},
+		"RemoveEdge": {
+			mutate: func(store *memoryStore[string, string]) error {
+				return store.RemoveEdge("a", "b")
+			},
+			expectedEdges: []EdgeKey[string]{{Source: "b", Target: "c"}},
+			expectedOrder: 3,
+		},
+		"RemoveEdges": {
+			mutate: func(store *memoryStore[string, string]) error {
+				return store.RemoveEdges([]Edge[string]{{Source: "a", Target: "b"}}, false)
+			},
+			expectedEdges: []EdgeKey[string]{{Source: "b", Target: "c"}},
+			expectedOrder: 3,
+		},
+		"Clear": {
+			mutate: func(store *memoryStore[string, string]) error {
+				return store.Clear()
+			},
+			expectedEdges: []EdgeKey[string]{},
+			expectedOrder: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := newMemoryStore[string, string]().(*memoryStore[string, string])
+
+			for _, vertex := range []string{"a", "b", "c"} {
+				_ = store.AddVertex(vertex, vertex, VertexProperties{})
+			}
+
+			_ = store.AddEdge("a", "b", Edge[string]{Source: "a", Target: "b"})
+			_ = store.AddEdge("b", "c", Edge[string]{Source: "b", Target: "c"})
+
+			adjacencyMap, _ := store.CachedAdjacencyMap()
+			predecessorMap, _ := store.CachedPredecessorMap()
+
+			// Subsequent calls without mutations must return the cached maps.
+			if cached, _ := store.CachedAdjacencyMap(); reflect.ValueOf(cached).Pointer() != reflect.ValueOf(adjacencyMap).Pointer() {
+				t.Errorf("expected cached adjacency map to be reused")
+			}
+
+			if cached, _ := store.CachedPredecessorMap(); reflect.ValueOf(cached).Pointer() != reflect.ValueOf(predecessorMap).Pointer() {
+				t.Errorf("expected cached predecessor map to be reused")
+			}
+
+			if err := test.mutate(store); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			adjacencyMap, _ = store.CachedAdjacencyMap()
+			predecessorMap, _ = store.CachedPredecessorMap()
+
+			if len(adjacencyMap) != test.expectedOrder || len(predecessorMap) != test.expectedOrder {
+				t.Errorf("expected %d vertices, got %d and %d", test.expectedOrder, len(adjacencyMap), len(predecessorMap))
+			}
+
+			size := 0
+			for _, edges := range adjacencyMap {
+				size += len(edges)
+			}
+
+			if size != len(test.expectedEdges) {
+				t.Errorf("expected %d edges, got %d", len(test.expectedEdges), size)
+			}
+
+			for _, edge := range test.expectedEdges {
+				if _, ok := adjacencyMap[edge.Source][edge.Target]; !ok {
+					t.Errorf("expected edge %v in adjacency map", edge)
+				}
+				if _, ok := predecessorMap[edge.Target][edge.Source]; !ok {
+					t.Errorf("expected edge %v in predecessor map", edge)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryStore_ShortestPaths(t *testing.T) {
+	g := New(StringHash, Directed(), Weighted())
+
+	for _, vertex := range []string{"A", "B", "C", "D", "E"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge("A", "B", EdgeWeight(4))
+	_ = g.AddEdge("A", "C", EdgeWeight(1))
+	_ = g.AddEdge("C", "B", EdgeWeight(2))
+	_ = g.AddEdge("B", "D", EdgeWeight(1))
+	_ = g.AddEdge("E", "A", EdgeWeight(1))
+
+	store := g.(*directed[string, string]).store.(*memoryStore[string, string])
+
+	weights, predecessors, err := store.ShortestPaths("A", edgeWeight[string])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expectedWeights, expectedPredecessors, _ := adjacencyMapDijkstra[string, string](g, "A", edgeWeight[string])
+
+	if len(weights) != len(expectedWeights) {
+		t.Fatalf("expected weights %v, got %v", expectedWeights, weights)
+	}
+
+	for vertex, expectedWeight := range expectedWeights {
+		if weights[vertex] != expectedWeight {
+			t.Errorf("expected weight %v for %v, got %v", expectedWeight, vertex, weights[vertex])
+		}
+		if predecessors[vertex] != expectedPredecessors[vertex] {
+			t.Errorf("expected predecessor %v for %v, got %v", expectedPredecessors[vertex], vertex, predecessors[vertex])
+		}
+	}
+
+	if _, ok := weights["E"]; ok {
+		t.Errorf("expected unreachable vertex E not to have a weight")
+	}
+
+	if weights["D"] != 4 {
+		t.Errorf("expected weight 4 for D, got %v", weights["D"])
+	}
+}
+
+func BenchmarkMemoryStore_AdjacencyMap(b *testing.B) {
+	g := New(IntHash, Directed())
+	_ = GridGraph(g, 30, 30)
+
+	b.Run("AdjacencyMap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = g.AdjacencyMap()
+		}
+	})
+
+	b.Run("readAdjacencyMap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = readAdjacencyMap(g)
+		}
+	})
+
+	b.Run("StronglyConnectedComponents", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = StronglyConnectedComponents(g)
+		}
+	})
+}
@@ -7,12 +7,18 @@ package graph
 //	g := graph.New(graph.IntHash, graph.Directed())
 //
 // This will set the IsDirected field to true.
+//
+// Note that IsAcyclic only declares that a graph is supposed to be acyclic and
+// isn't enforced by itself. Cycles are only rejected by AddEdge if PreventCycles
+// is set, which implies IsAcyclic.
 type Traits struct {
-	IsDirected    bool
-	IsAcyclic     bool
-	IsWeighted    bool
-	IsRooted      bool
-	PreventCycles bool
+	IsDirected        bool
+	IsAcyclic         bool
+	IsWeighted        bool
+	IsRooted          bool
+	PreventCycles     bool
+	AllowDuplicateAdd bool
+	AllowSelfLoops    bool
 }
 
 // Directed creates a directed graph. This has implications on graph traversal and the order of
@@ -61,3 +67,21 @@ func PreventCycles() func(*Traits) {
 		t.PreventCycles = true
 	}
 }
+
+// AllowDuplicateAdd makes adding an existing vertex or edge a no-op. Instead of returning
+// ErrVertexAlreadyExists or ErrEdgeAlreadyExists, AddVertex and AddEdge will succeed without
+// modifying the existing vertex or edge.
+func AllowDuplicateAdd() func(*Traits) {
+	return func(t *Traits) {
+		t.AllowDuplicateAdd = true
+	}
+}
+
+// AllowSelfLoops permits edges joining a vertex with itself. By default,
+// AddEdge rejects such self-loops with ErrSelfLoop, since they are usually
+// created by accident and several algorithms don't expect them.
+func AllowSelfLoops() func(*Traits) {
+	return func(t *Traits) {
+		t.AllowSelfLoops = true
+	}
+}
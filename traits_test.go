@@ -136,10 +136,56 @@ func TestPreventCycles(t *testing.T) {
 	}
 }
 
+func TestAllowDuplicateAdd(t *testing.T) {
+	tests := map[string]struct {
+		expected *Traits
+	}{
+		"allow duplicate add": {
+			expected: &Traits{
+				AllowDuplicateAdd: true,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		p := &Traits{}
+
+		AllowDuplicateAdd()(p)
+
+		if !traitsAreEqual(test.expected, p) {
+			t.Errorf("%s: trait expectation doesn't match: expected %v, got %v", name, test.expected, p)
+		}
+	}
+}
+
+func TestAllowSelfLoops(t *testing.T) {
+	tests := map[string]struct {
+		expected *Traits
+	}{
+		"allow self-loops": {
+			expected: &Traits{
+				AllowSelfLoops: true,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		p := &Traits{}
+
+		AllowSelfLoops()(p)
+
+		if !traitsAreEqual(test.expected, p) {
+			t.Errorf("%s: trait expectation doesn't match: expected %v, got %v", name, test.expected, p)
+		}
+	}
+}
+
 func traitsAreEqual(a, b *Traits) bool {
 	return a.IsAcyclic == b.IsAcyclic &&
 		a.IsDirected == b.IsDirected &&
 		a.IsRooted == b.IsRooted &&
 		a.IsWeighted == b.IsWeighted &&
-		a.PreventCycles == b.PreventCycles
+		a.PreventCycles == b.PreventCycles &&
+		a.AllowDuplicateAdd == b.AllowDuplicateAdd &&
+		a.AllowSelfLoops == b.AllowSelfLoops
 }
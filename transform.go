@@ -0,0 +1,343 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ReflexiveClosure adds a self-loop to each vertex of the given graph that
+// doesn't have one yet, so that the edge relation of the graph becomes
+// reflexive. The graph is modified in place and needs the AllowSelfLoops trait.
+func ReflexiveClosure[K comparable, T any](g Graph[K, T]) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for vertex, adjacencies := range adjacencyMap {
+		if _, ok := adjacencies[vertex]; ok {
+			continue
+		}
+		if err := g.AddEdge(vertex, vertex); err != nil {
+			return fmt.Errorf("failed to add self-loop for %v: %w", vertex, err)
+		}
+	}
+
+	return nil
+}
+
+// TransitiveClosure adds an edge (A,C) for each pair of vertices A and C where
+// C is reachable from A but not adjacent to A yet, so that the edge relation of
+// the graph becomes transitive. The graph is modified in place. A self-loop is
+// only added if a vertex is reachable from itself, i.e. if it is part of a
+// cycle. In an undirected graph, this is the case for each vertex that has an
+// edge. Adding self-loops requires the AllowSelfLoops trait.
+//
+// TransitiveClosure runs a DFS from each vertex and thus scales with
+// O(|V|(|V|+|E|)).
+func TransitiveClosure[K comparable, T any](g Graph[K, T]) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for vertex := range adjacencyMap {
+		visited := make(map[K]struct{})
+		stack := newStack[K]()
+
+		for adjacency := range adjacencyMap[vertex] {
+			stack.push(adjacency)
+		}
+
+		for !stack.isEmpty() {
+			current, _ := stack.pop()
+
+			if _, ok := visited[current]; ok {
+				continue
+			}
+			visited[current] = struct{}{}
+
+			for adjacency := range adjacencyMap[current] {
+				stack.push(adjacency)
+			}
+		}
+
+		for reachable := range visited {
+			if _, ok := adjacencyMap[vertex][reachable]; ok {
+				continue
+			}
+			// In an undirected graph, the reversed edge might have been added
+			// while processing the other vertex.
+			if _, err := g.Edge(vertex, reachable); err == nil {
+				continue
+			}
+			if err := g.AddEdge(vertex, reachable); err != nil {
+				return fmt.Errorf("failed to add edge (%v, %v): %w", vertex, reachable, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ToUndirected copies the given directed graph into the out graph, which has to
+// be an undirected graph, e.g. created using New without the Directed trait.
+// All vertices are copied along with their properties, and each directed edge
+// (A,B) becomes an undirected edge joining A and B.
+//
+// If g contains both (A,B) and (B,A), they are merged into a single undirected
+// edge. The merged edge takes the properties of the edge with the lower weight.
+// If both edges have the same weight, the properties of the edge whose source
+// comes first in the order of the vertex hashes are used.
+func ToUndirected[K comparable, T any](g, out Graph[K, T]) error {
+	if !g.Traits().IsDirected {
+		return errors.New("graph to convert must be directed")
+	}
+
+	if out.Traits().IsDirected {
+		return errors.New("output graph must be undirected")
+	}
+
+	if err := copyVertices(g, out); err != nil {
+		return err
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	merged := make(map[EdgeKey[K]]Edge[K], len(edges))
+
+	for _, edge := range edges {
+		key := EdgeKey[K]{Source: edge.Source, Target: edge.Target}
+		reverseKey := EdgeKey[K]{Source: edge.Target, Target: edge.Source}
+
+		reverse, ok := merged[reverseKey]
+		if !ok {
+			merged[key] = edge
+			continue
+		}
+
+		if edge.Properties.Weight < reverse.Properties.Weight ||
+			edge.Properties.Weight == reverse.Properties.Weight && keyLess(edge.Source, reverse.Source) {
+			delete(merged, reverseKey)
+			merged[key] = edge
+		}
+	}
+
+	for _, edge := range merged {
+		if err := out.AddEdge(copyEdge(edge)); err != nil {
+			return fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return nil
+}
+
+// ToDirected copies the given undirected graph into the out graph, which has to
+// be a directed graph, e.g. created using New with the Directed trait. All
+// vertices are copied along with their properties, and each undirected edge
+// joining A and B becomes two directed edges (A,B) and (B,A) with the same
+// properties. A self-loop only becomes a single directed edge.
+func ToDirected[K comparable, T any](g, out Graph[K, T]) error {
+	if g.Traits().IsDirected {
+		return errors.New("graph to convert must be undirected")
+	}
+
+	if !out.Traits().IsDirected {
+		return errors.New("output graph must be directed")
+	}
+
+	if err := copyVertices(g, out); err != nil {
+		return err
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	for _, edge := range edges {
+		if err := out.AddEdge(copyEdge(edge)); err != nil {
+			return fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+
+		if edge.Source == edge.Target {
+			continue
+		}
+
+		reverse := Edge[K]{
+			Source:     edge.Target,
+			Target:     edge.Source,
+			Properties: edge.Properties,
+		}
+
+		if err := out.AddEdge(copyEdge(reverse)); err != nil {
+			return fmt.Errorf("failed to add edge (%v, %v): %w", reverse.Source, reverse.Target, err)
+		}
+	}
+
+	return nil
+}
+
+// MapVertices creates a new graph whose vertices are the vertices of g converted
+// using the given transform function and hashed using the given hash function.
+// Each edge of g joins the converted vertices in the new graph. The new graph has
+// the same traits as g, and all vertex and edge properties are copied. If two
+// vertices are converted into vertices with the same hash, an error will be
+// returned.
+func MapVertices[K comparable, T any, L comparable, U any](g Graph[K, T], transform func(T) U, hash Hash[L, U]) (Graph[L, U], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	traits := *g.Traits()
+	h := New(hash, func(t *Traits) {
+		*t = traits
+	})
+
+	// newHashes maps the hash of each vertex of g to the hash of its converted
+	// vertex, and oldHashes does the opposite for detecting collisions.
+	newHashes := make(map[K]L, len(adjacencyMap))
+	oldHashes := make(map[L]K, len(adjacencyMap))
+
+	for oldHash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(oldHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", oldHash, err)
+		}
+
+		converted := transform(vertex)
+		newHash := hash(converted)
+
+		if other, ok := oldHashes[newHash]; ok {
+			return nil, fmt.Errorf("vertices %v and %v are both converted into a vertex with hash %v", other, oldHash, newHash)
+		}
+
+		if err := h.AddVertex(converted, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", newHash, err)
+		}
+
+		newHashes[oldHash] = newHash
+		oldHashes[newHash] = oldHash
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	for _, edge := range edges {
+		source, target := newHashes[edge.Source], newHashes[edge.Target]
+		_, _, copyProperties := copyEdge(edge)
+
+		if err := h.AddEdge(source, target, copyProperties); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+		}
+	}
+
+	return h, nil
+}
+
+// FilterVertices copies all vertices of g that satisfy the given keep function
+// into the out graph, along with all edges whose source and target vertex are
+// both kept. The result is the subgraph of g induced by the kept vertices. The
+// out graph is expected to be empty, otherwise the vertices or edges might
+// already exist.
+func FilterVertices[K comparable, T any](g Graph[K, T], keep func(value T, properties VertexProperties) bool, out Graph[K, T]) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	kept := make(map[K]struct{}, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		if !keep(vertex, properties) {
+			continue
+		}
+
+		if err := out.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+
+		kept[hash] = struct{}{}
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	for _, edge := range edges {
+		if _, ok := kept[edge.Source]; !ok {
+			continue
+		}
+		if _, ok := kept[edge.Target]; !ok {
+			continue
+		}
+
+		if err := out.AddEdge(copyEdge(edge)); err != nil {
+			return fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return nil
+}
+
+// FilterEdges copies all vertices of g into the out graph, along with all edges
+// that satisfy the given keep function. Each edge of an undirected graph is
+// passed to the keep function only once. The properties of the copied vertices
+// and edges are copied as well. The out graph is expected to be empty,
+// otherwise the vertices or edges might already exist.
+func FilterEdges[K comparable, T any](g Graph[K, T], keep func(edge Edge[K]) bool, out Graph[K, T]) error {
+	if err := copyVertices(g, out); err != nil {
+		return err
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	for _, edge := range edges {
+		if !keep(edge) {
+			continue
+		}
+
+		if err := out.AddEdge(copyEdge(edge)); err != nil {
+			return fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return nil
+}
+
+// copyVertices adds all vertices of g to the out graph along with their
+// properties.
+func copyVertices[K comparable, T any](g, out Graph[K, T]) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for hash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		if err := out.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+	}
+
+	return nil
+}
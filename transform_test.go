@@ -0,0 +1,521 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestReflexiveClosure(t *testing.T) {
+	tests := map[string]struct {
+		traits       []func(*Traits)
+		vertices     []int
+		edges        []Edge[int]
+		expectedSize int
+	}{
+		"directed graph": {
+			traits:   []func(*Traits){Directed(), AllowSelfLoops()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedSize: 5,
+		},
+		"undirected graph": {
+			traits:   []func(*Traits){AllowSelfLoops()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedSize: 4,
+		},
+		"graph with existing self-loop": {
+			traits:   []func(*Traits){Directed(), AllowSelfLoops()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+			},
+			expectedSize: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			if err := ReflexiveClosure(g); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			for _, vertex := range test.vertices {
+				if _, err := g.Edge(vertex, vertex); err != nil {
+					t.Errorf("expected self-loop for %v: %s", vertex, err.Error())
+				}
+			}
+
+			size, _ := g.Size()
+
+			if size != test.expectedSize {
+				t.Errorf("expected size %d, got %d", test.expectedSize, size)
+			}
+		})
+	}
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []int
+		edges         []Edge[int]
+		reflexive     bool
+		expectedEdges []Edge[int]
+	}{
+		"directed path": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+		},
+		"directed path with reflexive closure": {
+			traits:   []func(*Traits){Directed(), AllowSelfLoops()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			reflexive: true,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 2},
+			},
+		},
+		"undirected path": {
+			traits:   []func(*Traits){AllowSelfLoops()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 2, Target: 2},
+				{Source: 3, Target: 3},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			if test.reflexive {
+				if err := ReflexiveClosure(g); err != nil {
+					t.Fatalf("unexpected error: %s", err.Error())
+				}
+			}
+
+			if err := TransitiveClosure(g); err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			size, _ := g.Size()
+
+			if size != len(test.expectedEdges) {
+				t.Errorf("expected size %d, got %d", len(test.expectedEdges), size)
+			}
+
+			for _, edge := range test.expectedEdges {
+				if _, err := g.Edge(edge.Source, edge.Target); err != nil {
+					t.Errorf("expected edge (%v, %v): %s", edge.Source, edge.Target, err.Error())
+				}
+			}
+
+			if transitive, _ := IsTransitive(g); !transitive {
+				t.Errorf("expected graph to be transitive")
+			}
+		})
+	}
+}
+
+func TestToUndirected(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		outTraits     []func(*Traits)
+		edges         []Edge[int]
+		expectedEdges []Edge[int]
+		shouldFail    bool
+	}{
+		"opposite edges with different weights": {
+			traits:    []func(*Traits){Directed(), Weighted()},
+			outTraits: []func(*Traits){Weighted()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 3, Attributes: map[string]string{"lane": "b"}}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 4}},
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3, Attributes: map[string]string{"lane": "b"}}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 4}},
+			},
+		},
+		"opposite edges with equal weights": {
+			traits:    []func(*Traits){Directed(), Weighted()},
+			outTraits: []func(*Traits){Weighted()},
+			edges: []Edge[int]{
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 3, Attributes: map[string]string{"lane": "b"}}},
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3, Attributes: map[string]string{"lane": "a"}}},
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3, Attributes: map[string]string{"lane": "a"}}},
+			},
+		},
+		"undirected input graph": {
+			traits:     []func(*Traits){},
+			outTraits:  []func(*Traits){},
+			shouldFail: true,
+		},
+		"directed output graph": {
+			traits:     []func(*Traits){Directed()},
+			outTraits:  []func(*Traits){Directed()},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range []int{1, 2, 3, 4} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			out := New(IntHash, test.outTraits...)
+
+			err := ToUndirected(g, out)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if order, _ := out.Order(); order != 4 {
+				t.Errorf("expected order 4, got %d", order)
+			}
+
+			if size, _ := out.Size(); size != len(test.expectedEdges) {
+				t.Errorf("expected size %d, got %d", len(test.expectedEdges), size)
+			}
+
+			for _, expectedEdge := range test.expectedEdges {
+				edge, err := out.Edge(expectedEdge.Source, expectedEdge.Target)
+				if err != nil {
+					t.Fatalf("expected edge (%v, %v): %s", expectedEdge.Source, expectedEdge.Target, err.Error())
+				}
+
+				if edge.Properties.Weight != expectedEdge.Properties.Weight {
+					t.Errorf("expected weight %d, got %d", expectedEdge.Properties.Weight, edge.Properties.Weight)
+				}
+
+				for key, value := range expectedEdge.Properties.Attributes {
+					if edge.Properties.Attributes[key] != value {
+						t.Errorf("expected attribute %v=%v, got %v", key, value, edge.Properties.Attributes)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestToDirected(t *testing.T) {
+	g := New(IntHash, Weighted(), AllowSelfLoops())
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = g.AddVertex(vertex, VertexAttribute("color", "red"))
+	}
+
+	_ = g.AddEdge(1, 2, EdgeWeight(3))
+	_ = g.AddEdge(2, 3, EdgeWeight(4))
+	_ = g.AddEdge(3, 3, EdgeWeight(1))
+
+	out := New(IntHash, Directed(), Weighted(), AllowSelfLoops())
+
+	if err := ToDirected(g, out); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if size, _ := out.Size(); size != 5 {
+		t.Errorf("expected size 5, got %d", size)
+	}
+
+	expectedWeights := map[EdgeKey[int]]int{
+		{Source: 1, Target: 2}: 3,
+		{Source: 2, Target: 1}: 3,
+		{Source: 2, Target: 3}: 4,
+		{Source: 3, Target: 2}: 4,
+		{Source: 3, Target: 3}: 1,
+	}
+
+	for key, weight := range expectedWeights {
+		edge, err := out.Edge(key.Source, key.Target)
+		if err != nil {
+			t.Fatalf("expected edge (%v, %v): %s", key.Source, key.Target, err.Error())
+		}
+		if edge.Properties.Weight != weight {
+			t.Errorf("expected weight %d for edge (%v, %v), got %d", weight, key.Source, key.Target, edge.Properties.Weight)
+		}
+	}
+
+	_, properties, _ := out.VertexWithProperties(1)
+	if properties.Attributes["color"] != "red" {
+		t.Errorf("expected vertex properties to be copied, got %v", properties.Attributes)
+	}
+
+	if err := ToDirected(out, New(IntHash, Directed())); err == nil {
+		t.Errorf("expected error for directed input graph")
+	}
+
+	if err := ToDirected(g, New(IntHash)); err == nil {
+		t.Errorf("expected error for undirected output graph")
+	}
+}
+
+func TestMapVertices(t *testing.T) {
+	type city struct {
+		id   int
+		name string
+	}
+
+	g := New(func(c city) int { return c.id }, Directed(), Weighted())
+
+	_ = g.AddVertex(city{id: 1, name: "Berlin"}, VertexAttribute("country", "DE"))
+	_ = g.AddVertex(city{id: 2, name: "Paris"})
+	_ = g.AddVertex(city{id: 3, name: "Rome"})
+
+	_ = g.AddEdge(1, 2, EdgeWeight(1050))
+	_ = g.AddEdge(2, 3, EdgeWeight(1420), EdgeAttribute("mode", "train"))
+
+	h, err := MapVertices(g, func(c city) string {
+		return c.name
+	}, StringHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if !traitsAreEqual(h.Traits(), g.Traits()) {
+		t.Errorf("expected traits %+v, got %+v", g.Traits(), h.Traits())
+	}
+
+	if order, _ := h.Order(); order != 3 {
+		t.Errorf("expected order 3, got %d", order)
+	}
+
+	if size, _ := h.Size(); size != 2 {
+		t.Errorf("expected size 2, got %d", size)
+	}
+
+	_, properties, err := h.VertexWithProperties("Berlin")
+	if err != nil {
+		t.Fatalf("expected vertex Berlin: %s", err.Error())
+	}
+	if properties.Attributes["country"] != "DE" {
+		t.Errorf("expected vertex properties to be copied, got %v", properties.Attributes)
+	}
+
+	edge, err := h.Edge("Paris", "Rome")
+	if err != nil {
+		t.Fatalf("expected edge (Paris, Rome): %s", err.Error())
+	}
+	if edge.Properties.Weight != 1420 || edge.Properties.Attributes["mode"] != "train" {
+		t.Errorf("expected edge properties to be copied, got %+v", edge.Properties)
+	}
+
+	if _, err := h.Edge("Rome", "Paris"); err == nil {
+		t.Errorf("expected edge direction to be preserved")
+	}
+
+	_, err = MapVertices(g, func(c city) string {
+		return "city"
+	}, StringHash)
+	if err == nil {
+		t.Errorf("expected error for colliding hashes")
+	}
+}
+
+func TestFilterVertices(t *testing.T) {
+	tests := map[string]struct {
+		traits           []func(*Traits)
+		colors           map[int]string
+		edges            []Edge[int]
+		expectedVertices []int
+		expectedEdges    []Edge[int]
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed()},
+			colors: map[int]string{1: "red", 2: "red", 3: "blue", 4: "red"},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			expectedVertices: []int{1, 2, 4},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 4, Target: 1},
+			},
+		},
+		"undirected graph": {
+			traits: []func(*Traits){},
+			colors: map[int]string{1: "red", 2: "blue", 3: "red"},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedVertices: []int{1, 3},
+			expectedEdges: []Edge[int]{
+				{Source: 3, Target: 1},
+			},
+		},
+		"no kept vertices": {
+			traits:           []func(*Traits){},
+			colors:           map[int]string{1: "blue", 2: "blue"},
+			edges:            []Edge[int]{{Source: 1, Target: 2}},
+			expectedVertices: []int{},
+			expectedEdges:    []Edge[int]{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for vertex, color := range test.colors {
+				_ = g.AddVertex(vertex, VertexAttribute("color", color))
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			out := New(IntHash, test.traits...)
+
+			err := FilterVertices(g, func(_ int, properties VertexProperties) bool {
+				return properties.Attributes["color"] == "red"
+			}, out)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			adjacencyMap, _ := out.AdjacencyMap()
+
+			vertices := make([]int, 0, len(adjacencyMap))
+			for vertex := range adjacencyMap {
+				vertices = append(vertices, vertex)
+			}
+
+			if !slicesAreEqual(vertices, test.expectedVertices) {
+				t.Errorf("expected vertices %v, got %v", test.expectedVertices, vertices)
+			}
+
+			if size, _ := out.Size(); size != len(test.expectedEdges) {
+				t.Errorf("expected size %d, got %d", len(test.expectedEdges), size)
+			}
+
+			for _, edge := range test.expectedEdges {
+				if _, err := out.Edge(edge.Source, edge.Target); err != nil {
+					t.Errorf("expected edge (%v, %v): %s", edge.Source, edge.Target, err.Error())
+				}
+			}
+
+			for _, vertex := range vertices {
+				_, properties, _ := out.VertexWithProperties(vertex)
+				if properties.Attributes["color"] != "red" {
+					t.Errorf("expected vertex properties of %v to be copied, got %v", vertex, properties.Attributes)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterEdges(t *testing.T) {
+	g := New(IntHash, Weighted())
+
+	for _, vertex := range []int{1, 2, 3, 4} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2, EdgeWeight(1))
+	_ = g.AddEdge(2, 3, EdgeWeight(5))
+	_ = g.AddEdge(3, 4, EdgeWeight(2))
+	_ = g.AddEdge(4, 1, EdgeWeight(7))
+
+	out := New(IntHash, Weighted())
+	calls := 0
+
+	err := FilterEdges(g, func(edge Edge[int]) bool {
+		calls++
+		return edge.Properties.Weight < 5
+	}, out)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if calls != 4 {
+		t.Errorf("expected keep function to be called 4 times, got %d", calls)
+	}
+
+	if order, _ := out.Order(); order != 4 {
+		t.Errorf("expected order 4, got %d", order)
+	}
+
+	if size, _ := out.Size(); size != 2 {
+		t.Errorf("expected size 2, got %d", size)
+	}
+
+	for _, key := range []EdgeKey[int]{{Source: 1, Target: 2}, {Source: 4, Target: 3}} {
+		if _, err := out.Edge(key.Source, key.Target); err != nil {
+			t.Errorf("expected edge (%v, %v): %s", key.Source, key.Target, err.Error())
+		}
+	}
+}
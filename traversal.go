@@ -1,6 +1,10 @@
 package graph
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+)
 
 // DFS performs a depth-first search on the graph, starting from the given vertex. The visit
 // function will be invoked with the hash of the vertex currently visited. If it returns false, DFS
@@ -33,9 +37,21 @@ import "fmt"
 //
 // DFS is non-recursive and maintains a stack instead.
 func DFS[K comparable, T any](g Graph[K, T], start K, visit func(K) bool) error {
-	adjacencyMap, err := g.AdjacencyMap()
+	return dfs(g, start, Downstream, visit)
+}
+
+// DFSUp works just as DFS, but follows the ingoing instead of the outgoing edges
+// of each vertex using the predecessor map. Starting from the given vertex, it
+// visits the ancestors of that vertex without having to transpose the graph.
+// For undirected graphs, DFSUp is the same as DFS.
+func DFSUp[K comparable, T any](g Graph[K, T], start K, visit func(K) bool) error {
+	return dfs(g, start, Upstream, visit)
+}
+
+func dfs[K comparable, T any](g Graph[K, T], start K, direction Direction, visit func(K) bool) error {
+	adjacencyMap, err := directedAdjacencyMap(g, direction)
 	if err != nil {
-		return fmt.Errorf("could not get adjacency map: %w", err)
+		return err
 	}
 
 	if _, ok := adjacencyMap[start]; !ok {
@@ -100,7 +116,18 @@ func BFS[K comparable, T any](g Graph[K, T], start K, visit func(K) bool) error
 	ignoreDepth := func(vertex K, _ int) bool {
 		return visit(vertex)
 	}
-	return BFSWithDepth(g, start, ignoreDepth)
+	return bfsWithDepth(g, start, Downstream, ignoreDepth)
+}
+
+// BFSUp works just as BFS, but follows the ingoing instead of the outgoing edges
+// of each vertex using the predecessor map. Starting from the given vertex, it
+// visits the ancestors of that vertex without having to transpose the graph.
+// For undirected graphs, BFSUp is the same as BFS.
+func BFSUp[K comparable, T any](g Graph[K, T], start K, visit func(K) bool) error {
+	ignoreDepth := func(vertex K, _ int) bool {
+		return visit(vertex)
+	}
+	return bfsWithDepth(g, start, Upstream, ignoreDepth)
 }
 
 // BFSWithDepth works just as BFS and performs a breadth-first search on the graph, but its
@@ -115,9 +142,13 @@ func BFS[K comparable, T any](g Graph[K, T], start K, visit func(K) bool) error
 // With the visit function from the example, the BFS traversal will stop once a depth greater
 // than 3 is reached.
 func BFSWithDepth[K comparable, T any](g Graph[K, T], start K, visit func(K, int) bool) error {
-	adjacencyMap, err := g.AdjacencyMap()
+	return bfsWithDepth(g, start, Downstream, visit)
+}
+
+func bfsWithDepth[K comparable, T any](g Graph[K, T], start K, direction Direction, visit func(K, int) bool) error {
+	adjacencyMap, err := directedAdjacencyMap(g, direction)
 	if err != nil {
-		return fmt.Errorf("could not get adjacency map: %w", err)
+		return err
 	}
 
 	if _, ok := adjacencyMap[start]; !ok {
@@ -153,3 +184,609 @@ func BFSWithDepth[K comparable, T any](g Graph[K, T], start K, visit func(K, int
 
 	return nil
 }
+
+// BFSLevels groups the vertices that are reachable from the given source vertex
+// by their breadth-first search level, i.e. by their hop distance from the
+// source. levels[0] only contains the source, levels[1] contains its adjacent
+// vertices, levels[2] the vertices two hops away, and so on. Vertices that
+// aren't reachable from the source are not contained in any level.
+//
+// The order of the vertices within a level is not guaranteed to be stable. If
+// the source vertex doesn't exist, an error will be returned.
+func BFSLevels[K comparable, T any](g Graph[K, T], source K) ([][]K, error) {
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[source]; !ok {
+		return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+	}
+
+	visited := map[K]struct{}{source: {}}
+	levels := [][]K{{source}}
+
+	for {
+		level := make([]K, 0)
+
+		for _, vertex := range levels[len(levels)-1] {
+			for adjacency := range adjacencyMap[vertex] {
+				if _, ok := visited[adjacency]; ok {
+					continue
+				}
+				visited[adjacency] = struct{}{}
+				level = append(level, adjacency)
+			}
+		}
+
+		if len(level) == 0 {
+			break
+		}
+
+		levels = append(levels, level)
+	}
+
+	return levels, nil
+}
+
+// Neighborhood copies all vertices that are at most radius hops away from the
+// given center vertex into the out graph, along with all edges of g joining two
+// of these vertices and their properties. The out graph should be a new, empty
+// graph, e.g. created using [NewLike]. The hops are determined using a BFS that
+// ignores edge weights and follows the outgoing edges in a directed graph. If the
+// center vertex doesn't exist or the radius is negative, an error is returned.
+func Neighborhood[K comparable, T any](g Graph[K, T], center K, radius int, out Graph[K, T]) error {
+	if radius < 0 {
+		return fmt.Errorf("radius must not be negative, got %d", radius)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[center]; !ok {
+		return fmt.Errorf("could not find center vertex with hash %v", center)
+	}
+
+	hops := map[K]int{center: 0}
+	queue := []K{center}
+
+	for len(queue) > 0 {
+		currentHash := queue[0]
+		queue = queue[1:]
+
+		if hops[currentHash] == radius {
+			continue
+		}
+
+		for adjacency := range adjacencyMap[currentHash] {
+			if _, ok := hops[adjacency]; !ok {
+				hops[adjacency] = hops[currentHash] + 1
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	for hash := range hops {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return fmt.Errorf("could not get vertex %v: %w", hash, err)
+		}
+
+		if err := out.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return fmt.Errorf("could not add vertex %v: %w", hash, err)
+		}
+	}
+
+	added := make(map[EdgeKey[K]]struct{})
+
+	for source := range hops {
+		for target, edge := range adjacencyMap[source] {
+			if _, ok := hops[target]; !ok {
+				continue
+			}
+
+			// An undirected edge is contained in the adjacency map twice, but
+			// may only be added once.
+			if !g.Traits().IsDirected {
+				if _, ok := added[EdgeKey[K]{Source: target, Target: source}]; ok {
+					continue
+				}
+			}
+
+			if err := out.AddEdge(copyEdge(edge)); err != nil {
+				return fmt.Errorf("could not add edge (%v, %v): %w", source, target, err)
+			}
+
+			added[EdgeKey[K]{Source: source, Target: target}] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// BFSTree performs a breadth-first search starting from the given vertex and
+// returns the resulting spanning tree as a parent map, mapping each visited
+// vertex except for the start vertex to the vertex it has been discovered from.
+// Following the parents back to the start vertex yields a path with the least
+// number of edges. If the start vertex doesn't exist, an error will be returned.
+func BFSTree[K comparable, T any](g Graph[K, T], start K) (map[K]K, error) {
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[start]; !ok {
+		return nil, fmt.Errorf("could not find start vertex with hash %v", start)
+	}
+
+	parents := make(map[K]K)
+	visited := map[K]struct{}{start: {}}
+	queue := []K{start}
+
+	for len(queue) > 0 {
+		currentHash := queue[0]
+		queue = queue[1:]
+
+		for adjacency := range adjacencyMap[currentHash] {
+			if _, ok := visited[adjacency]; !ok {
+				visited[adjacency] = struct{}{}
+				parents[adjacency] = currentHash
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	return parents, nil
+}
+
+// DFSTree works just as [BFSTree], but performs a depth-first search. Each
+// visited vertex is mapped to the vertex it has been visited from, so the tree
+// reflects the branches explored by [DFS]. The start vertex isn't contained in
+// the returned map.
+func DFSTree[K comparable, T any](g Graph[K, T], start K) (map[K]K, error) {
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	if _, ok := adjacencyMap[start]; !ok {
+		return nil, fmt.Errorf("could not find start vertex with hash %v", start)
+	}
+
+	parents := make(map[K]K)
+	visited := make(map[K]struct{})
+
+	// Each element of the stack is the edge a vertex has been discovered by,
+	// so that its parent is known once the vertex is visited.
+	stack := newStack[EdgeKey[K]]()
+	stack.push(EdgeKey[K]{Source: start, Target: start})
+
+	for !stack.isEmpty() {
+		edge, _ := stack.pop()
+
+		if _, ok := visited[edge.Target]; ok {
+			continue
+		}
+		visited[edge.Target] = struct{}{}
+
+		if edge.Target != start {
+			parents[edge.Target] = edge.Source
+		}
+
+		for adjacency := range adjacencyMap[edge.Target] {
+			if _, ok := visited[adjacency]; !ok {
+				stack.push(EdgeKey[K]{Source: edge.Target, Target: adjacency})
+			}
+		}
+	}
+
+	return parents, nil
+}
+
+// Direction determines which edges a traversal follows.
+type Direction int
+
+const (
+	// Downstream follows the outgoing edges of each vertex, i.e. the edges of
+	// the adjacency map, and visits the descendants of the start vertex.
+	Downstream Direction = iota
+
+	// Upstream follows the ingoing edges of each vertex, i.e. the edges of the
+	// predecessor map, and visits the ancestors of the start vertex. For
+	// undirected graphs, Upstream is the same as Downstream.
+	Upstream
+)
+
+// TraversalOrder determines the order in which a traversal visits the vertices.
+type TraversalOrder int
+
+const (
+	// BreadthFirst visits the vertices in breadth-first order, i.e. all
+	// vertices of a given distance before the vertices of a greater distance.
+	BreadthFirst TraversalOrder = iota
+
+	// DepthFirst visits the vertices in depth-first order, i.e. the vertices of
+	// a branch before backtracking to the next branch.
+	DepthFirst
+)
+
+// SkipAll can be returned by the visit function of [WalkVertices] to stop the
+// traversal. In contrast to any other error, it is not returned by the walk
+// function itself.
+var SkipAll = errors.New("skip all vertices")
+
+// WalkVertices traverses the graph from the given start vertex, following the
+// edges in the given direction and visiting the vertices in the given order.
+// Each reachable vertex, starting with the start vertex itself, is passed to the
+// visit function exactly once. If the visit function returns an error, the
+// traversal is stopped and the error is returned, except for [SkipAll], which
+// stops the traversal without an error. If the start vertex doesn't exist, an
+// error will be returned.
+func WalkVertices[K comparable, T any](g Graph[K, T], start K, direction Direction, order TraversalOrder, visit func(K) error) error {
+	adjacencyMap, err := directedAdjacencyMap(g, direction)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := adjacencyMap[start]; !ok {
+		return fmt.Errorf("could not find start vertex with hash %v", start)
+	}
+
+	err = walkAdjacencyMap(adjacencyMap, start, order, visit)
+	if errors.Is(err, SkipAll) {
+		return nil
+	}
+
+	return err
+}
+
+// directedAdjacencyMap returns the adjacency map of the given graph if the
+// direction is Downstream, and its predecessor map if it is Upstream.
+func directedAdjacencyMap[K comparable, T any](g Graph[K, T], direction Direction) (map[K]map[K]Edge[K], error) {
+	if direction == Upstream {
+		predecessorMap, err := readPredecessorMap(g)
+		if err != nil {
+			return nil, fmt.Errorf("could not get predecessor map: %w", err)
+		}
+		return predecessorMap, nil
+	}
+
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	return adjacencyMap, nil
+}
+
+// walkAdjacencyMap visits each vertex reachable from the start vertex once in
+// the given order and stops as soon as the visit function returns an error.
+func walkAdjacencyMap[K comparable](adjacencyMap map[K]map[K]Edge[K], start K, order TraversalOrder, visit func(K) error) error {
+	visited := make(map[K]struct{})
+
+	if order == DepthFirst {
+		stack := newStack[K]()
+		stack.push(start)
+
+		for !stack.isEmpty() {
+			currentHash, _ := stack.pop()
+
+			if _, ok := visited[currentHash]; ok {
+				continue
+			}
+			visited[currentHash] = struct{}{}
+
+			if err := visit(currentHash); err != nil {
+				return err
+			}
+
+			for adjacency := range adjacencyMap[currentHash] {
+				if _, ok := visited[adjacency]; !ok {
+					stack.push(adjacency)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	queue := []K{start}
+	visited[start] = struct{}{}
+
+	for len(queue) > 0 {
+		currentHash := queue[0]
+		queue = queue[1:]
+
+		if err := visit(currentHash); err != nil {
+			return err
+		}
+
+		for adjacency := range adjacencyMap[currentHash] {
+			if _, ok := visited[adjacency]; !ok {
+				visited[adjacency] = struct{}{}
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReachableFromAny returns the set of all vertices that are reachable from at
+// least one of the given source vertices, including the sources themselves. It
+// performs a single breadth-first search that starts with all sources in its
+// queue. If one of the sources doesn't exist, an error will be returned.
+func ReachableFromAny[K comparable, T any](g Graph[K, T], sources []K) (map[K]struct{}, error) {
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	queue := make([]K, 0, len(sources))
+	reachable := make(map[K]struct{})
+
+	for _, source := range sources {
+		if _, ok := adjacencyMap[source]; !ok {
+			return nil, fmt.Errorf("could not find source vertex with hash %v", source)
+		}
+		if _, ok := reachable[source]; ok {
+			continue
+		}
+		reachable[source] = struct{}{}
+		queue = append(queue, source)
+	}
+
+	for len(queue) > 0 {
+		currentHash := queue[0]
+		queue = queue[1:]
+
+		for adjacency := range adjacencyMap[currentHash] {
+			if _, ok := reachable[adjacency]; !ok {
+				reachable[adjacency] = struct{}{}
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	return reachable, nil
+}
+
+// ReachableWithout determines whether the target vertex is reachable from the
+// source vertex if the removed vertex didn't exist, i.e. whether there is a
+// path from source to target that doesn't pass through the removed vertex.
+// This answers questions like "if X fails, can A still reach B?" without
+// having to remove X from the graph, which remains unchanged.
+//
+// If the source or the target is the removed vertex itself, it is considered
+// unreachable. If one of the vertices doesn't exist, an error will be returned.
+func ReachableWithout[K comparable, T any](g Graph[K, T], source, target, removed K) (bool, error) {
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return false, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	for _, vertex := range []K{source, target, removed} {
+		if _, ok := adjacencyMap[vertex]; !ok {
+			return false, fmt.Errorf("could not find vertex with hash %v", vertex)
+		}
+	}
+
+	if source == removed || target == removed {
+		return false, nil
+	}
+
+	queue := []K{source}
+	visited := map[K]struct{}{source: {}}
+
+	for len(queue) > 0 {
+		currentHash := queue[0]
+		queue = queue[1:]
+
+		if currentHash == target {
+			return true, nil
+		}
+
+		for adjacency := range adjacencyMap[currentHash] {
+			if adjacency == removed {
+				continue
+			}
+			if _, ok := visited[adjacency]; !ok {
+				visited[adjacency] = struct{}{}
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// CommonDescendants returns the set of vertices that are reachable from both of
+// the given vertices. A vertex is only considered a descendant of itself if it
+// is part of a cycle. If one of the vertices doesn't exist, an error will be
+// returned.
+func CommonDescendants[K comparable, T any](g Graph[K, T], a, b K) (map[K]struct{}, error) {
+	adjacencyMap, err := readAdjacencyMap(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	return commonReachable(adjacencyMap, a, b)
+}
+
+// CommonAncestors returns the set of vertices from which both of the given
+// vertices are reachable. A vertex is only considered an ancestor of itself if it
+// is part of a cycle. If one of the vertices doesn't exist, an error will be
+// returned.
+func CommonAncestors[K comparable, T any](g Graph[K, T], a, b K) (map[K]struct{}, error) {
+	predecessorMap, err := readPredecessorMap(g)
+	if err != nil {
+		return nil, fmt.Errorf("could not get predecessor map: %w", err)
+	}
+
+	return commonReachable(predecessorMap, a, b)
+}
+
+// commonReachable returns the intersection of the vertices reachable from a and
+// b via at least one edge of the given adjacency map.
+func commonReachable[K comparable](adjacencyMap map[K]map[K]Edge[K], a, b K) (map[K]struct{}, error) {
+	for _, vertex := range []K{a, b} {
+		if _, ok := adjacencyMap[vertex]; !ok {
+			return nil, fmt.Errorf("could not find vertex with hash %v", vertex)
+		}
+	}
+
+	reachableFromA := reachableVia(adjacencyMap, a)
+	reachableFromB := reachableVia(adjacencyMap, b)
+
+	common := make(map[K]struct{})
+
+	for vertex := range reachableFromA {
+		if _, ok := reachableFromB[vertex]; ok {
+			common[vertex] = struct{}{}
+		}
+	}
+
+	return common, nil
+}
+
+// reachableVia returns all vertices reachable from the start vertex via at
+// least one edge. Therefore, the start vertex is only contained in the result
+// if it is part of a cycle.
+func reachableVia[K comparable](adjacencyMap map[K]map[K]Edge[K], start K) map[K]struct{} {
+	reachable := make(map[K]struct{})
+	queue := make([]K, 0)
+
+	for adjacency := range adjacencyMap[start] {
+		reachable[adjacency] = struct{}{}
+		queue = append(queue, adjacency)
+	}
+
+	for len(queue) > 0 {
+		currentHash := queue[0]
+		queue = queue[1:]
+
+		for adjacency := range adjacencyMap[currentHash] {
+			if _, ok := reachable[adjacency]; !ok {
+				reachable[adjacency] = struct{}{}
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	return reachable
+}
+
+// AliasSampler draws random successors of a vertex, where the probability of
+// each successor is proportional to the weight of the edge leading to it. It
+// uses Vose's alias method, so building the sampler takes O(n) time for n
+// outgoing edges, and each sample can be drawn in O(1) time.
+type AliasSampler[K comparable] struct {
+	targets     []K
+	probability []float64
+	alias       []int
+}
+
+// NewAliasSampler creates an [AliasSampler] for the outgoing edges of the given
+// vertex. For weighted graphs, the edge weights are used as relative sampling
+// probabilities and must not be negative. For unweighted graphs, each successor
+// is equally likely.
+//
+// If the vertex doesn't exist, has no outgoing edges, or if all edge weights
+// are 0, an error will be returned.
+func NewAliasSampler[K comparable, T any](g Graph[K, T], vertex K) (*AliasSampler[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	adjacencies, ok := adjacencyMap[vertex]
+	if !ok {
+		return nil, &VertexNotFoundError[K]{Key: vertex}
+	}
+
+	n := len(adjacencies)
+	if n == 0 {
+		return nil, fmt.Errorf("vertex %v has no outgoing edges", vertex)
+	}
+
+	sampler := &AliasSampler[K]{
+		targets:     make([]K, 0, n),
+		probability: make([]float64, n),
+		alias:       make([]int, n),
+	}
+
+	weights := make([]float64, 0, n)
+	total := 0.0
+
+	for target, edge := range adjacencies {
+		weight := 1.0
+		if g.Traits().IsWeighted {
+			if edge.Properties.Weight < 0 {
+				return nil, fmt.Errorf("edge (%v, %v) has a negative weight", vertex, target)
+			}
+			weight = float64(edge.Properties.Weight)
+		}
+		sampler.targets = append(sampler.targets, target)
+		weights = append(weights, weight)
+		total += weight
+	}
+
+	if total == 0 {
+		return nil, errors.New("all outgoing edges have a weight of 0")
+	}
+
+	// Scale the weights so that their average is 1 and split them into those
+	// below and above the average. Each small entry is then filled up with the
+	// excess of a large entry, which becomes its alias.
+	scaled := make([]float64, n)
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+
+	for i, weight := range weights {
+		scaled[i] = weight * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small, large = small[:len(small)-1], large[:len(large)-1]
+
+		sampler.probability[s] = scaled[s]
+		sampler.alias[s] = l
+
+		scaled[l] = scaled[l] + scaled[s] - 1
+		if scaled[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+
+	// Due to floating-point inaccuracies, some entries might be left over.
+	// Their probability is 1 by definition.
+	for _, i := range append(small, large...) {
+		sampler.probability[i] = 1
+	}
+
+	return sampler, nil
+}
+
+// Sample draws a random successor using the given random number generator. For
+// reproducible results, use a generator with a fixed seed. Note that the order
+// of the successors within the sampler is not stable across different builds of
+// the sampler, so the same seed might yield different successors.
+func (a *AliasSampler[K]) Sample(rng *rand.Rand) K {
+	i := rng.Intn(len(a.targets))
+
+	if rng.Float64() < a.probability[i] {
+		return a.targets[i]
+	}
+
+	return a.targets[a.alias[i]]
+}
@@ -1,7 +1,10 @@
 package graph
 
 import (
+	"errors"
 	"log"
+	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -335,6 +338,109 @@ func TestDirectedBFS(t *testing.T) {
 	}
 }
 
+func TestDirectedDFSUp(t *testing.T) {
+	tests := map[string]struct {
+		edges          []Edge[int]
+		startHash      int
+		expectedVisits []int
+		stopAtVertex   int
+	}{
+		"visit all ancestors": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			startHash:      4,
+			expectedVisits: []int{4, 2, 1, 3},
+			stopAtVertex:   -1,
+		},
+		"stop at ancestor": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			startHash:      4,
+			expectedVisits: []int{4, 3, 2},
+			stopAtVertex:   2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for _, edge := range test.edges {
+				_ = g.AddVertex(edge.Source)
+				_ = g.AddVertex(edge.Target)
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			visited := make([]int, 0)
+
+			err := DFSUp(g, test.startHash, func(value int) bool {
+				visited = append(visited, value)
+				return value == test.stopAtVertex
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if !slicesAreEqual(visited, test.expectedVisits) {
+				t.Errorf("expected visits %v, got %v", test.expectedVisits, visited)
+			}
+
+			if visited[0] != test.startHash {
+				t.Errorf("expected start vertex %v to be visited first, got %v", test.startHash, visited[0])
+			}
+		})
+	}
+}
+
+func TestDirectedBFSUp(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 1; i <= 6; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	// 1 and 2 are parents of 3, 3 is the parent of 4, and 5 is a descendant
+	// of 4 that must not be visited.
+	_ = g.AddEdge(1, 3)
+	_ = g.AddEdge(2, 3)
+	_ = g.AddEdge(3, 4)
+	_ = g.AddEdge(4, 5)
+	_ = g.AddEdge(6, 1)
+
+	visited := make([]int, 0)
+
+	err := BFSUp(g, 4, func(value int) bool {
+		visited = append(visited, value)
+		return false
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	expectedVisits := []int{4, 3, 1, 2, 6}
+
+	if !slicesAreEqual(visited, expectedVisits) {
+		t.Fatalf("expected visits %v, got %v", expectedVisits, visited)
+	}
+
+	if visited[0] != 4 || visited[1] != 3 || visited[4] != 6 {
+		t.Errorf("expected visits in breadth-first order, got %v", visited)
+	}
+
+	if err := BFSUp(g, 7, func(int) bool { return false }); err == nil {
+		t.Errorf("expected error for non-existent start vertex")
+	}
+}
+
 func TestUndirectedBFS(t *testing.T) {
 	tests := map[string]struct {
 		vertices       []int
@@ -413,3 +519,933 @@ func TestUndirectedBFS(t *testing.T) {
 		}
 	}
 }
+
+func TestNewAliasSampler(t *testing.T) {
+	tests := map[string]struct {
+		traits                []func(*Traits)
+		edges                 []Edge[string]
+		vertex                string
+		expectedProbabilities map[string]float64
+		shouldFail            bool
+	}{
+		"weighted out-edges": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "D", Properties: EdgeProperties{Weight: 6}},
+				{Source: "B", Target: "A", Properties: EdgeProperties{Weight: 100}},
+			},
+			vertex: "A",
+			expectedProbabilities: map[string]float64{
+				"B": 0.1,
+				"C": 0.3,
+				"D": 0.6,
+			},
+		},
+		"edge with zero weight": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 0}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+			},
+			vertex: "A",
+			expectedProbabilities: map[string]float64{
+				"B": 0,
+				"C": 1,
+			},
+		},
+		"unweighted graph": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+			},
+			vertex: "A",
+			expectedProbabilities: map[string]float64{
+				"B": 0.5,
+				"C": 0.5,
+			},
+		},
+		"vertex without out-edges": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+			},
+			vertex:     "B",
+			shouldFail: true,
+		},
+		"negative weight": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: -1}},
+			},
+			vertex:     "A",
+			shouldFail: true,
+		},
+		"non-existent vertex": {
+			traits:     []func(*Traits){Directed()},
+			edges:      []Edge[string]{},
+			vertex:     "X",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range []string{"A", "B", "C", "D"} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			sampler, err := NewAliasSampler(g, test.vertex)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			const samples = 100000
+
+			rng := rand.New(rand.NewSource(42))
+			counts := make(map[string]int)
+
+			for i := 0; i < samples; i++ {
+				counts[sampler.Sample(rng)]++
+			}
+
+			for target := range counts {
+				if _, ok := test.expectedProbabilities[target]; !ok {
+					t.Errorf("unexpected sample %v", target)
+				}
+			}
+
+			for target, expected := range test.expectedProbabilities {
+				actual := float64(counts[target]) / samples
+				if math.Abs(actual-expected) > 0.01 {
+					t.Errorf("expected probability %v for %v, got %v", expected, target, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestBFSLevels(t *testing.T) {
+	tests := map[string]struct {
+		traits         []func(*Traits)
+		vertices       []int
+		edges          []Edge[int]
+		source         int
+		expectedLevels [][]int
+		shouldFail     bool
+	}{
+		"path graph": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			source:         1,
+			expectedLevels: [][]int{{1}, {2}, {3}, {4}},
+		},
+		"directed graph with shortcut": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 1, Target: 5},
+				{Source: 6, Target: 1},
+			},
+			source:         1,
+			expectedLevels: [][]int{{1}, {2, 3, 5}, {4}},
+		},
+		"isolated source": {
+			traits:         []func(*Traits){},
+			vertices:       []int{1, 2},
+			source:         1,
+			expectedLevels: [][]int{{1}},
+		},
+		"non-existent source": {
+			traits:     []func(*Traits){},
+			vertices:   []int{1},
+			source:     2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			levels, err := BFSLevels(g, test.source)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if len(levels) != len(test.expectedLevels) {
+				t.Fatalf("expected levels %v, got %v", test.expectedLevels, levels)
+			}
+
+			for i, level := range levels {
+				if !slicesAreEqual(level, test.expectedLevels[i]) {
+					t.Errorf("expected level %d to be %v, got %v", i, test.expectedLevels[i], level)
+				}
+			}
+		})
+	}
+}
+
+func TestNeighborhood(t *testing.T) {
+	tests := map[string]struct {
+		traits           []func(*Traits)
+		edges            []Edge[int]
+		center           int
+		radius           int
+		expectedVertices []int
+		expectedSize     int
+		shouldFail       bool
+	}{
+		"undirected path with radius 1": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			center:           3,
+			radius:           1,
+			expectedVertices: []int{2, 3, 4},
+			expectedSize:     2,
+		},
+		"undirected graph with induced edge": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			center:           1,
+			radius:           1,
+			expectedVertices: []int{1, 2, 3},
+			expectedSize:     3,
+		},
+		"directed graph with radius 2": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 3, Target: 1},
+				{Source: 5, Target: 1},
+			},
+			center:           1,
+			radius:           2,
+			expectedVertices: []int{1, 2, 3},
+			expectedSize:     3,
+		},
+		"radius 0": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			center:           1,
+			radius:           0,
+			expectedVertices: []int{1},
+			expectedSize:     0,
+		},
+		"negative radius": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			center:     1,
+			radius:     -1,
+			shouldFail: true,
+		},
+		"non-existent center": {
+			traits:     []func(*Traits){},
+			center:     1,
+			radius:     1,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, edge := range test.edges {
+				_ = g.AddVertex(edge.Source)
+				_ = g.AddVertex(edge.Target)
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			out := NewLike(g)
+
+			err := Neighborhood(g, test.center, test.radius, out)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			adjacencyMap, _ := out.AdjacencyMap()
+
+			vertices := make([]int, 0, len(adjacencyMap))
+			for vertex := range adjacencyMap {
+				vertices = append(vertices, vertex)
+			}
+
+			if !slicesAreEqual(vertices, test.expectedVertices) {
+				t.Errorf("expected vertices %v, got %v", test.expectedVertices, vertices)
+			}
+
+			if size, _ := out.Size(); size != test.expectedSize {
+				t.Errorf("expected size %d, got %d", test.expectedSize, size)
+			}
+		})
+	}
+}
+
+func TestBFSTree(t *testing.T) {
+	tests := map[string]struct {
+		traits          []func(*Traits)
+		vertices        []int
+		edges           []Edge[int]
+		start           int
+		expectedParents map[int]int
+		shouldFail      bool
+	}{
+		"directed graph with shortcut": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 1, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 6, Target: 1},
+			},
+			start:           1,
+			expectedParents: map[int]int{2: 1, 3: 2, 4: 1, 5: 4},
+		},
+		"undirected tree": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+			},
+			start:           3,
+			expectedParents: map[int]int{2: 3, 1: 2, 4: 2},
+		},
+		"isolated start vertex": {
+			traits:          []func(*Traits){},
+			vertices:        []int{1, 2},
+			start:           1,
+			expectedParents: map[int]int{},
+		},
+		"non-existent start vertex": {
+			traits:     []func(*Traits){},
+			vertices:   []int{1},
+			start:      2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			parents, err := BFSTree(g, test.start)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if len(parents) != len(test.expectedParents) || !mapsAreEqual(parents, test.expectedParents) {
+				t.Errorf("expected parents %v, got %v", test.expectedParents, parents)
+			}
+		})
+	}
+}
+
+func TestDFSTree(t *testing.T) {
+	tests := map[string]struct {
+		traits   []func(*Traits)
+		vertices []int
+		edges    []Edge[int]
+		start    int
+		expected []int
+	}{
+		"directed graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 1, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 6, Target: 1},
+			},
+			start:    1,
+			expected: []int{2, 3, 4, 5},
+		},
+		"undirected cycle": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			start:    1,
+			expected: []int{2, 3, 4},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			parents, err := DFSTree(g, test.start)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			vertices := make([]int, 0, len(parents))
+
+			// Each parent has to be joined with its child, and following the
+			// parents has to lead back to the start vertex.
+			for vertex, parent := range parents {
+				vertices = append(vertices, vertex)
+
+				if _, err := g.Edge(parent, vertex); err != nil {
+					t.Errorf("expected edge (%v, %v) for parent %v of %v", parent, vertex, parent, vertex)
+				}
+
+				current := vertex
+				for steps := 0; current != test.start; steps++ {
+					if steps > len(parents) {
+						t.Fatalf("expected parents of %v to lead to %v, got %v", vertex, test.start, parents)
+					}
+					current = parents[current]
+				}
+			}
+
+			if !slicesAreEqual(vertices, test.expected) {
+				t.Errorf("expected vertices %v in tree, got %v", test.expected, vertices)
+			}
+		})
+	}
+
+	g := New(IntHash)
+
+	if _, err := DFSTree(g, 1); err == nil {
+		t.Errorf("expected error for non-existent start vertex")
+	}
+}
+
+func TestWalkVertices(t *testing.T) {
+	errVisit := errors.New("visit failed")
+
+	tests := map[string]struct {
+		traits          []func(*Traits)
+		edges           []Edge[int]
+		start           int
+		direction       Direction
+		order           TraversalOrder
+		stopAt          int
+		stopErr         error
+		expectedVisited []int
+		expectedErr     error
+		shouldFail      bool
+	}{
+		"downstream breadth-first": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 5, Target: 1},
+			},
+			start:           1,
+			direction:       Downstream,
+			order:           BreadthFirst,
+			expectedVisited: []int{1, 2, 3, 4},
+		},
+		"upstream depth-first": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 5, Target: 1},
+			},
+			start:           3,
+			direction:       Upstream,
+			order:           DepthFirst,
+			expectedVisited: []int{3, 2, 1, 5},
+		},
+		"undirected upstream": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			start:           3,
+			direction:       Upstream,
+			order:           DepthFirst,
+			expectedVisited: []int{3, 2, 1},
+		},
+		"stop with SkipAll": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			start:           1,
+			direction:       Downstream,
+			order:           DepthFirst,
+			stopAt:          3,
+			stopErr:         SkipAll,
+			expectedVisited: []int{1, 2, 3},
+		},
+		"stop with error": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			start:           1,
+			direction:       Downstream,
+			order:           BreadthFirst,
+			stopAt:          2,
+			stopErr:         errVisit,
+			expectedVisited: []int{1, 2},
+			expectedErr:     errVisit,
+			shouldFail:      true,
+		},
+		"non-existent start vertex": {
+			traits:     []func(*Traits){Directed()},
+			start:      1,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, edge := range test.edges {
+				_ = g.AddVertex(edge.Source)
+				_ = g.AddVertex(edge.Target)
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			visited := make([]int, 0)
+
+			err := WalkVertices(g, test.start, test.direction, test.order, func(vertex int) error {
+				visited = append(visited, vertex)
+				if vertex == test.stopAt {
+					return test.stopErr
+				}
+				return nil
+			})
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+				t.Errorf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedVisited == nil {
+				return
+			}
+
+			if test.order == DepthFirst || test.stopAt != 0 {
+				// The traversed graphs only allow for a single order.
+				if len(visited) != len(test.expectedVisited) {
+					t.Fatalf("expected visited vertices %v, got %v", test.expectedVisited, visited)
+				}
+				for i, vertex := range test.expectedVisited {
+					if visited[i] != vertex {
+						t.Errorf("expected visited vertices %v, got %v", test.expectedVisited, visited)
+						break
+					}
+				}
+				return
+			}
+
+			if !slicesAreEqual(visited, test.expectedVisited) {
+				t.Errorf("expected visited vertices %v, got %v", test.expectedVisited, visited)
+			}
+		})
+	}
+}
+
+func TestDirectedReachableFromAny(t *testing.T) {
+	tests := map[string]struct {
+		vertices          []int
+		edges             []Edge[int]
+		sources           []int
+		expectedReachable []int
+		shouldFail        bool
+	}{
+		"two sources": {
+			vertices: []int{1, 2, 3, 4, 5, 6, 7},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 3},
+				{Source: 6, Target: 1},
+			},
+			sources:           []int{1, 4},
+			expectedReachable: []int{1, 2, 3, 4, 5},
+		},
+		"duplicate sources": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			sources:           []int{1, 1},
+			expectedReachable: []int{1, 2},
+		},
+		"no sources": {
+			vertices:          []int{1, 2},
+			edges:             []Edge[int]{{Source: 1, Target: 2}},
+			sources:           []int{},
+			expectedReachable: []int{},
+		},
+		"non-existent source": {
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			sources:    []int{1, 3},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			reachable, err := ReachableFromAny(g, test.sources)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			union := make(map[int]struct{})
+			for _, source := range test.sources {
+				_ = DFS(g, source, func(vertex int) bool {
+					union[vertex] = struct{}{}
+					return false
+				})
+			}
+
+			if len(reachable) != len(union) {
+				t.Errorf("expected %d vertices from individual traversals, got %d", len(union), len(reachable))
+			}
+
+			if len(reachable) != len(test.expectedReachable) {
+				t.Fatalf("expected %d reachable vertices, got %d", len(test.expectedReachable), len(reachable))
+			}
+
+			for _, vertex := range test.expectedReachable {
+				if _, ok := reachable[vertex]; !ok {
+					t.Errorf("expected vertex %v to be reachable", vertex)
+				}
+				if _, ok := union[vertex]; !ok {
+					t.Errorf("expected vertex %v to be reachable individually", vertex)
+				}
+			}
+		})
+	}
+}
+
+func TestReachableWithout(t *testing.T) {
+	tests := map[string]struct {
+		traits            []func(*Traits)
+		vertices          []int
+		edges             []Edge[int]
+		source, target    int
+		removed           int
+		expectedReachable bool
+		shouldFail        bool
+	}{
+		"single intermediate vertex is the only route": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 4, Target: 3},
+			},
+			source:            1,
+			target:            3,
+			removed:           2,
+			expectedReachable: false,
+		},
+		"alternative route": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 4, Target: 3},
+			},
+			source:            1,
+			target:            3,
+			removed:           2,
+			expectedReachable: true,
+		},
+		"removed vertex not on any route": {
+			traits:   []func(*Traits){},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:            2,
+			target:            1,
+			removed:           3,
+			expectedReachable: true,
+		},
+		"removed source": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			source:            1,
+			target:            2,
+			removed:           1,
+			expectedReachable: false,
+		},
+		"non-existent removed vertex": {
+			traits:     []func(*Traits){Directed()},
+			vertices:   []int{1, 2},
+			source:     1,
+			target:     2,
+			removed:    3,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			reachable, err := ReachableWithout(g, test.source, test.target, test.removed)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if reachable != test.expectedReachable {
+				t.Errorf("expected reachable == %v, got %v", test.expectedReachable, reachable)
+			}
+
+			if order, _ := g.Order(); order != len(test.vertices) {
+				t.Errorf("expected graph to remain unchanged with order %d, got %d", len(test.vertices), order)
+			}
+		})
+	}
+}
+
+func TestDirectedCommonDescendants(t *testing.T) {
+	tests := map[string]struct {
+		edges             []Edge[string]
+		a, b              string
+		expectedCommon    []string
+		expectedAncestors []string
+		shouldFail        bool
+	}{
+		"DAG with merge point": {
+			edges: []Edge[string]{
+				{Source: "R", Target: "A"},
+				{Source: "R", Target: "B"},
+				{Source: "A", Target: "M"},
+				{Source: "B", Target: "M"},
+				{Source: "M", Target: "E"},
+				{Source: "A", Target: "X"},
+				{Source: "B", Target: "Y"},
+			},
+			a:                 "A",
+			b:                 "B",
+			expectedCommon:    []string{"M", "E"},
+			expectedAncestors: []string{"R"},
+		},
+		"one vertex is a descendant of the other": {
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+			},
+			a:                 "A",
+			b:                 "B",
+			expectedCommon:    []string{"C"},
+			expectedAncestors: []string{},
+		},
+		"cycle": {
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "A"},
+			},
+			a:                 "A",
+			b:                 "B",
+			expectedCommon:    []string{"A", "B"},
+			expectedAncestors: []string{"A", "B"},
+		},
+		"non-existent vertex": {
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+			},
+			a:          "A",
+			b:          "Z",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, Directed())
+
+			for _, edge := range test.edges {
+				_ = g.AddVertex(edge.Source)
+				_ = g.AddVertex(edge.Target)
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			common, err := CommonDescendants(g, test.a, test.b)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			ancestors, err := CommonAncestors(g, test.a, test.b)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error == %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if len(common) != len(test.expectedCommon) {
+				t.Errorf("expected common descendants %v, got %v", test.expectedCommon, common)
+			}
+
+			for _, vertex := range test.expectedCommon {
+				if _, ok := common[vertex]; !ok {
+					t.Errorf("expected %v to be a common descendant, got %v", vertex, common)
+				}
+			}
+
+			if len(ancestors) != len(test.expectedAncestors) {
+				t.Errorf("expected common ancestors %v, got %v", test.expectedAncestors, ancestors)
+			}
+
+			for _, vertex := range test.expectedAncestors {
+				if _, ok := ancestors[vertex]; !ok {
+					t.Errorf("expected %v to be a common ancestor, got %v", vertex, ancestors)
+				}
+			}
+		})
+	}
+}
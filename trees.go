@@ -10,6 +10,9 @@ import (
 //
 // The MST contains all vertices from the given graph as well as the required
 // edges for building the MST. The original graph remains unchanged.
+//
+// Edges with equal weights are considered in a deterministic order, so calling
+// MinimumSpanningTree on the same graph always yields the same tree.
 func MinimumSpanningTree[K comparable, T any](g Graph[K, T]) (Graph[K, T], error) {
 	return spanningTree(g, false)
 }
@@ -27,7 +30,7 @@ func spanningTree[K comparable, T any](g Graph[K, T], maximum bool) (Graph[K, T]
 		return nil, errors.New("spanning trees can only be determined for undirected graphs")
 	}
 
-	adjacencyMap, err := g.AdjacencyMap()
+	adjacencyMap, err := readAdjacencyMap(g)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
 	}
@@ -55,15 +58,7 @@ func spanningTree[K comparable, T any](g Graph[K, T], maximum bool) (Graph[K, T]
 		}
 	}
 
-	if maximum {
-		sort.Slice(edges, func(i, j int) bool {
-			return edges[i].Properties.Weight > edges[j].Properties.Weight
-		})
-	} else {
-		sort.Slice(edges, func(i, j int) bool {
-			return edges[i].Properties.Weight < edges[j].Properties.Weight
-		})
-	}
+	edgesByWeight(edges, maximum)
 
 	for _, edge := range edges {
 		sourceRoot := subtrees.find(edge.Source)
@@ -80,3 +75,88 @@ func spanningTree[K comparable, T any](g Graph[K, T], maximum bool) (Graph[K, T]
 
 	return mst, nil
 }
+
+// edgesByWeight sorts the given edges by their weight in ascending order, or in
+// descending order if maximum is true. Edges with equal weights are ordered by
+// their source and target hashes, so that the order doesn't depend on the map
+// iteration order used for collecting the edges.
+func edgesByWeight[K comparable](edges []Edge[K], maximum bool) {
+	sort.Slice(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+
+		if a.Properties.Weight != b.Properties.Weight {
+			if maximum {
+				return a.Properties.Weight > b.Properties.Weight
+			}
+			return a.Properties.Weight < b.Properties.Weight
+		}
+
+		if a.Source != b.Source {
+			return keyLess(a.Source, b.Source)
+		}
+
+		return keyLess(a.Target, b.Target)
+	})
+}
+
+// IsTree determines whether the given graph is a tree, i.e. whether it has at
+// least one vertex, is connected, and doesn't contain any cycles. This is the
+// case if the graph is a forest with exactly |V|-1 edges.
+//
+// The edge directions of a directed graph are ignored, so a directed graph is a
+// tree if its underlying undirected graph is a tree. Two edges (A,B) and (B,A)
+// form a cycle, and so does a self-loop.
+func IsTree[K comparable, T any](g Graph[K, T]) (bool, error) {
+	order, err := g.Order()
+	if err != nil {
+		return false, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if order == 0 {
+		return false, nil
+	}
+
+	edges, err := UndirectedEdges(g)
+	if err != nil {
+		return false, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	if len(edges) != order-1 {
+		return false, nil
+	}
+
+	return IsForest(g)
+}
+
+// IsForest determines whether the given graph is a forest, i.e. whether it
+// doesn't contain any cycles. In contrast to [IsTree], the graph doesn't need
+// to be connected, so each of its connected components is a tree. A graph
+// without vertices is a forest.
+//
+// Just as IsTree, IsForest ignores the edge directions of a directed graph.
+func IsForest[K comparable, T any](g Graph[K, T]) (bool, error) {
+	cyclomaticNumber, err := CyclomaticNumber(g)
+	if err != nil {
+		return false, fmt.Errorf("failed to compute cyclomatic number: %w", err)
+	}
+
+	return cyclomaticNumber == 0, nil
+}
+
+// keyLess provides a deterministic ordering for arbitrary hash values. Strings
+// and integers are compared by their values, all other types are compared by
+// their default string representation.
+func keyLess[K comparable](a, b K) bool {
+	switch x := any(a).(type) {
+	case string:
+		return x < any(b).(string)
+	case int:
+		return x < any(b).(int)
+	case int64:
+		return x < any(b).(int64)
+	case uint64:
+		return x < any(b).(uint64)
+	}
+
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
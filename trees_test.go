@@ -213,3 +213,168 @@ func TestUndirectedMaximumSpanningTree(t *testing.T) {
 		})
 	}
 }
+
+func TestUndirectedMinimumSpanningTree_deterministic(t *testing.T) {
+	g := New(StringHash)
+
+	vertices := []string{"A", "B", "C", "D", "E"}
+	for _, vertex := range vertices {
+		_ = g.AddVertex(vertex)
+	}
+
+	// All edges of the complete graph have the same weight, so every spanning
+	// tree is a minimum spanning tree.
+	for i, source := range vertices {
+		for _, target := range vertices[i+1:] {
+			_ = g.AddEdge(source, target, EdgeWeight(1))
+		}
+	}
+
+	mst, err := MinimumSpanningTree(g)
+	if err != nil {
+		t.Fatalf("failed to get minimum spanning tree: %s", err.Error())
+	}
+
+	expected, _ := mst.AdjacencyMap()
+
+	for i := 0; i < 20; i++ {
+		mst, err := MinimumSpanningTree(g)
+		if err != nil {
+			t.Fatalf("failed to get minimum spanning tree: %s", err.Error())
+		}
+
+		adjacencyMap, _ := mst.AdjacencyMap()
+
+		if !adjacencyMapsAreEqual(expected, adjacencyMap, func(a, b Edge[string]) bool {
+			return a.Source == b.Source && a.Target == b.Target
+		}) {
+			t.Fatalf("expected adjacency map %v, got %v in run %d", expected, adjacencyMap, i)
+		}
+	}
+}
+
+func TestIsTree(t *testing.T) {
+	tests := map[string]struct {
+		traits         *Traits
+		vertices       []int
+		edges          []Edge[int]
+		expectedTree   bool
+		expectedForest bool
+	}{
+		"undirected tree": {
+			traits:   &Traits{},
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 3, Target: 5},
+			},
+			expectedTree:   true,
+			expectedForest: true,
+		},
+		"undirected forest": {
+			traits:   &Traits{},
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 4},
+			},
+			expectedTree:   false,
+			expectedForest: true,
+		},
+		"undirected graph with cycle": {
+			traits:   &Traits{},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedTree:   false,
+			expectedForest: false,
+		},
+		"undirected graph with self-loop": {
+			traits:   &Traits{AllowSelfLoops: true},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 2},
+			},
+			expectedTree:   false,
+			expectedForest: false,
+		},
+		"directed tree": {
+			traits:   &Traits{IsDirected: true},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+				{Source: 2, Target: 4},
+			},
+			expectedTree:   true,
+			expectedForest: true,
+		},
+		"directed graph with opposite edges": {
+			traits:   &Traits{IsDirected: true},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expectedTree:   false,
+			expectedForest: false,
+		},
+		"single vertex": {
+			traits:         &Traits{},
+			vertices:       []int{1},
+			expectedTree:   true,
+			expectedForest: true,
+		},
+		"empty graph": {
+			traits:         &Traits{},
+			expectedTree:   false,
+			expectedForest: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var g Graph[int, int]
+
+			if test.traits.IsDirected {
+				g = newDirected(IntHash, test.traits, newMemoryStore[int, int]())
+			} else {
+				g = newUndirected(IntHash, test.traits, newMemoryStore[int, int]())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			isTree, err := IsTree(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if isTree != test.expectedTree {
+				t.Errorf("expected tree == %v, got %v", test.expectedTree, isTree)
+			}
+
+			isForest, err := IsForest(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+
+			if isForest != test.expectedForest {
+				t.Errorf("expected forest == %v, got %v", test.expectedForest, isForest)
+			}
+		})
+	}
+}
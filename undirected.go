@@ -35,7 +35,12 @@ func (u *undirected[K, T]) AddVertex(value T, options ...func(*VertexProperties)
 		option(&prop)
 	}
 
-	return u.store.AddVertex(hash, value, prop)
+	err := u.store.AddVertex(hash, value, prop)
+	if u.traits.AllowDuplicateAdd && errors.Is(err, ErrVertexAlreadyExists) {
+		return nil
+	}
+
+	return err
 }
 
 func (u *undirected[K, T]) Vertex(hash K) (T, error) {
@@ -57,6 +62,18 @@ func (u *undirected[K, T]) RemoveVertex(hash K) error {
 }
 
 func (u *undirected[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
+	// Adding an existing edge is a no-op if the user allowed duplicates. This
+	// check has to happen before the cycle check, which would fail otherwise.
+	if u.traits.AllowDuplicateAdd {
+		if _, err := u.store.Edge(sourceHash, targetHash); err == nil {
+			return nil
+		}
+	}
+
+	if sourceHash == targetHash && !u.traits.AllowSelfLoops {
+		return &SelfLoopError[K]{Key: sourceHash}
+	}
+
 	// If the user opted in to preventing cycles, run a cycle check.
 	if u.traits.PreventCycles {
 		createsCycle, err := u.createsCycle(sourceHash, targetHash)
@@ -89,8 +106,20 @@ func (u *undirected[K, T]) AddEdgesFrom(g Graph[K, T]) error {
 		return fmt.Errorf("failed to get edges: %w", err)
 	}
 
-	for _, edge := range edges {
-		if err := u.AddEdge(copyEdge(edge)); err != nil {
+	if edges, err = validateEdges[K, T](u, edges); err != nil {
+		return err
+	}
+
+	for i, edge := range edges {
+		if err := u.addEdge(edge.Source, edge.Target, copiedEdge(edge)); err != nil {
+			// Roll back the edges that have already been added to keep the
+			// operation atomic, even if the store fails unexpectedly.
+			for _, addedEdge := range edges[:i] {
+				_ = u.store.RemoveEdge(addedEdge.Source, addedEdge.Target)
+				if addedEdge.Source != addedEdge.Target {
+					_ = u.store.RemoveEdge(addedEdge.Target, addedEdge.Source)
+				}
+			}
 			return fmt.Errorf("failed to add (%v, %v): %w", edge.Source, edge.Target, err)
 		}
 	}
@@ -152,10 +181,6 @@ func (u *undirected[K, T]) Edge(sourceHash, targetHash K) (Edge[T], error) {
 	}, nil
 }
 
-type tuple[K comparable] struct {
-	source, target K
-}
-
 func (u *undirected[K, T]) Edges() ([]Edge[K], error) {
 	storedEdges, err := u.store.ListEdges()
 	if err != nil {
@@ -171,16 +196,16 @@ func (u *undirected[K, T]) Edges() ([]Edge[K], error) {
 	// it also checks if the reversed edge has already been added - e.g., for
 	// an edge (A,B), Edges checks if the edge has been added as (B,A).
 	//
-	// These reversed edges are built as a custom tuple type, which is then used
-	// as a map key for access in O(1) time. It looks scarier than it is.
+	// These reversed edges are built as an EdgeKey, which is then used as a map
+	// key for access in O(1) time. It looks scarier than it is.
 	edges := make([]Edge[K], 0, len(storedEdges)/2)
 
-	added := make(map[tuple[K]]struct{})
+	added := make(map[EdgeKey[K]]struct{})
 
 	for _, storedEdge := range storedEdges {
-		reversedEdge := tuple[K]{
-			source: storedEdge.Target,
-			target: storedEdge.Source,
+		reversedEdge := EdgeKey[K]{
+			Source: storedEdge.Target,
+			Target: storedEdge.Source,
 		}
 		if _, ok := added[reversedEdge]; ok {
 			continue
@@ -188,9 +213,9 @@ func (u *undirected[K, T]) Edges() ([]Edge[K], error) {
 
 		edges = append(edges, storedEdge)
 
-		addedEdge := tuple[K]{
-			source: storedEdge.Source,
-			target: storedEdge.Target,
+		addedEdge := EdgeKey[K]{
+			Source: storedEdge.Source,
+			Target: storedEdge.Target,
 		}
 
 		added[addedEdge] = struct{}{}
@@ -233,6 +258,15 @@ func (u *undirected[K, T]) RemoveEdge(source, target K) error {
 }
 
 func (u *undirected[K, T]) AdjacencyMap() (map[K]map[K]Edge[K], error) {
+	// If the underlying store implements AdjacencyMap, use that fast path.
+	// Since each edge is stored in both directions, the outgoing edges are
+	// all edges of a vertex.
+	if am, ok := u.store.(interface {
+		AdjacencyMap() (map[K]map[K]Edge[K], error)
+	}); ok {
+		return am.AdjacencyMap()
+	}
+
 	vertices, err := u.store.ListVertices()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list vertices: %w", err)
@@ -262,10 +296,13 @@ func (u *undirected[K, T]) PredecessorMap() (map[K]map[K]Edge[K], error) {
 
 func (u *undirected[K, T]) Clone() (Graph[K, T], error) {
 	traits := &Traits{
-		IsDirected: u.traits.IsDirected,
-		IsAcyclic:  u.traits.IsAcyclic,
-		IsWeighted: u.traits.IsWeighted,
-		IsRooted:   u.traits.IsRooted,
+		IsDirected:        u.traits.IsDirected,
+		IsAcyclic:         u.traits.IsAcyclic,
+		IsWeighted:        u.traits.IsWeighted,
+		IsRooted:          u.traits.IsRooted,
+		PreventCycles:     u.traits.PreventCycles,
+		AllowDuplicateAdd: u.traits.AllowDuplicateAdd,
+		AllowSelfLoops:    u.traits.AllowSelfLoops,
 	}
 
 	clone := &undirected[K, T]{
@@ -290,13 +327,14 @@ func (u *undirected[K, T]) Order() (int, error) {
 }
 
 func (u *undirected[K, T]) Size() (int, error) {
-	edges, err := u.store.ListEdges()
+	// Every edge is stored twice, except for self-loops which are only stored
+	// once. Instead of dividing the number of stored edges by 2, count the
+	// deduplicated edges as returned by Edges.
+	edges, err := u.Edges()
 	if err != nil {
 		return 0, fmt.Errorf("failed to list edges: %w", err)
 	}
-	// Divide by 2 since every add edge operation on undirected graph is counted
-	// twice.
-	return len(edges) / 2, nil
+	return len(edges), nil
 }
 
 func (u *undirected[K, T]) edgesAreEqual(a, b Edge[T]) bool {
@@ -333,6 +371,12 @@ func (u *undirected[K, T]) addEdge(sourceHash, targetHash K, edge Edge[K]) error
 		return err
 	}
 
+	// A self-loop joins the vertex with itself, so the reversed edge would be
+	// the very same edge.
+	if sourceHash == targetHash {
+		return nil
+	}
+
 	rEdge := Edge[K]{
 		Source: edge.Target,
 		Target: edge.Source,
@@ -345,8 +389,84 @@ func (u *undirected[K, T]) addEdge(sourceHash, targetHash K, edge Edge[K]) error
 
 	err = u.store.AddEdge(targetHash, sourceHash, rEdge)
 	if err != nil {
+		// Remove the edge in the first direction again, so that a failed
+		// insertion doesn't leave a half-added edge behind.
+		_ = u.store.RemoveEdge(sourceHash, targetHash)
 		return err
 	}
 
 	return nil
 }
+
+func (u *undirected[K, T]) shortestPaths(source K, edgeWeight func(Edge[K]) float64) (map[K]float64, map[K]K, error) {
+	// If the underlying store implements ShortestPaths, use that fast path.
+	if sp, ok := u.store.(interface {
+		ShortestPaths(source K, edgeWeight func(Edge[K]) float64) (map[K]float64, map[K]K, error)
+	}); ok {
+		return sp.ShortestPaths(source, edgeWeight)
+	}
+
+	// Slow path.
+	return adjacencyMapDijkstra[K, T](u, source, edgeWeight)
+}
+
+func (u *undirected[K, T]) degrees() (map[K]int, error) {
+	// If the underlying store implements Degrees, use that fast path. Since
+	// each edge is stored in both directions, the out-degrees are sufficient.
+	if ds, ok := u.store.(interface {
+		Degrees() (map[K]int, map[K]int, error)
+	}); ok {
+		outDegrees, _, err := ds.Degrees()
+		return outDegrees, err
+	}
+
+	// Slow path.
+	return adjacencyMapDegrees[K, T](u)
+}
+
+func (u *undirected[K, T]) cachedAdjacencyMap() (map[K]map[K]Edge[K], error) {
+	// If the underlying store implements CachedAdjacencyMap, use that fast
+	// path. Since each edge is stored in both directions, the outgoing edges
+	// are all edges of a vertex.
+	if cs, ok := u.store.(interface {
+		CachedAdjacencyMap() (map[K]map[K]Edge[K], error)
+	}); ok {
+		return cs.CachedAdjacencyMap()
+	}
+
+	// Slow path.
+	return u.AdjacencyMap()
+}
+
+func (u *undirected[K, T]) cachedPredecessorMap() (map[K]map[K]Edge[K], error) {
+	return u.cachedAdjacencyMap()
+}
+
+func (u *undirected[K, T]) removeEdges(edges []Edge[K], ignoreMissing bool) error {
+	// If the underlying store implements RemoveEdges, use that fast path. Since
+	// each edge is stored in both directions, both of them have to be removed.
+	if rs, ok := u.store.(interface {
+		RemoveEdges(edges []Edge[K], ignoreMissing bool) error
+	}); ok {
+		bothDirections := make([]Edge[K], 0, 2*len(edges))
+		for _, edge := range edges {
+			bothDirections = append(bothDirections, edge, Edge[K]{Source: edge.Target, Target: edge.Source})
+		}
+		return rs.RemoveEdges(bothDirections, ignoreMissing)
+	}
+
+	// Slow path.
+	return removeEdgesOneByOne[K, T](u, edges, ignoreMissing)
+}
+
+func (u *undirected[K, T]) clear() error {
+	// If the underlying store implements Clear, use that fast path.
+	if cs, ok := u.store.(interface {
+		Clear() error
+	}); ok {
+		return cs.Clear()
+	}
+
+	// Slow path.
+	return clearOneByOne[K, T](u)
+}
@@ -567,6 +567,14 @@ func TestUndirected_RemoveVertex(t *testing.T) {
 			vertex:        1,
 			expectedError: ErrVertexHasEdges,
 		},
+		"vertex only appearing as edge target": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			vertex:        2,
+			expectedError: ErrVertexHasEdges,
+		},
 		"non-existent vertex": {
 			vertices:      []int{1, 2, 3},
 			edges:         []Edge[int]{},
@@ -594,6 +602,182 @@ func TestUndirected_RemoveVertex(t *testing.T) {
 	}
 }
 
+func TestUndirected_AllowDuplicateAdd(t *testing.T) {
+	g := New(IntHash, PreventCycles(), AllowDuplicateAdd())
+
+	_ = g.AddVertex(1, VertexWeight(10))
+	_ = g.AddVertex(2)
+
+	if err := g.AddVertex(1, VertexWeight(20)); err != nil {
+		t.Fatalf("expected duplicate vertex to be accepted, got %v", err)
+	}
+
+	if _, properties, _ := g.VertexWithProperties(1); properties.Weight != 10 {
+		t.Errorf("expected existing vertex weight 10 to be kept, got %v", properties.Weight)
+	}
+
+	_ = g.AddEdge(1, 2, EdgeWeight(5))
+
+	// Adding the edge again must neither fail due to the duplicate edge nor due
+	// to the cycle check.
+	if err := g.AddEdge(1, 2, EdgeWeight(7)); err != nil {
+		t.Fatalf("expected duplicate edge to be accepted, got %v", err)
+	}
+
+	if edge, _ := g.Edge(1, 2); edge.Properties.Weight != 5 {
+		t.Errorf("expected existing edge weight 5 to be kept, got %v", edge.Properties.Weight)
+	}
+
+	if err := g.AddEdge(1, 3); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+
+	source := New(IntHash)
+	_ = source.AddVertex(1)
+	_ = source.AddVertex(2)
+	_ = source.AddVertex(3)
+	_ = source.AddEdge(1, 2)
+	_ = source.AddEdge(2, 3)
+
+	_ = g.AddVertex(3)
+
+	if err := g.AddEdgesFrom(source); err != nil {
+		t.Fatalf("expected overlapping edges to be accepted, got %v", err)
+	}
+
+	if size, _ := g.Size(); size != 2 {
+		t.Errorf("expected 2 edges, got %d", size)
+	}
+}
+
+func TestUndirected_AddEdgesFrom_atomic(t *testing.T) {
+	tests := map[string]struct {
+		traits           []func(*Traits)
+		edges            []Edge[int]
+		existingVertices []int
+		existingEdges    []Edge[int]
+		expectedError    error
+	}{
+		"edge with non-existing vertex": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			existingVertices: []int{1, 2, 3},
+			expectedError:    ErrVertexNotFound,
+		},
+		"one duplicated edge": {
+			traits: []func(*Traits){},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			existingVertices: []int{1, 2, 3, 4},
+			existingEdges: []Edge[int]{
+				{Source: 2, Target: 3},
+			},
+			expectedError: ErrEdgeAlreadyExists,
+		},
+		"edges creating a cycle": {
+			traits: []func(*Traits){PreventCycles()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			existingVertices: []int{1, 2, 3},
+			expectedError:    ErrEdgeCreatesCycle,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			source := New(IntHash)
+
+			for _, vertex := range []int{1, 2, 3, 4} {
+				_ = source.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = source.AddEdge(copyEdge(edge))
+			}
+
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.existingVertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.existingEdges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			err := g.AddEdgesFrom(source)
+
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf("expected error %v, got %v", test.expectedError, err)
+			}
+
+			size, _ := g.Size()
+			if size != len(test.existingEdges) {
+				t.Errorf("expected %d edges after failed insertion, got %d", len(test.existingEdges), size)
+			}
+		})
+	}
+}
+
+func TestUndirected_AddEdgesFrom_concurrentAdd(t *testing.T) {
+	source := New(IntHash)
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = source.AddVertex(vertex)
+	}
+
+	_ = source.AddEdge(1, 2)
+	_ = source.AddEdge(2, 3)
+
+	store := &concurrentAddStore[int, int]{
+		Store: newMemoryStore[int, int](),
+		edge:  Edge[int]{Source: 2, Target: 3},
+	}
+	g := NewWithStore[int, int](IntHash, store)
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = g.AddVertex(vertex)
+	}
+
+	if err := g.AddEdgesFrom(source); !errors.Is(err, ErrEdgeAlreadyExists) {
+		t.Fatalf("expected error %v, got %v", ErrEdgeAlreadyExists, err)
+	}
+
+	if _, err := g.Edge(2, 3); err != nil {
+		t.Errorf("expected concurrently added edge to be kept, got %v", err)
+	}
+
+	if _, err := g.Edge(1, 2); !errors.Is(err, ErrEdgeNotFound) {
+		t.Errorf("expected added edge to be rolled back, got %v", err)
+	}
+}
+
+// concurrentAddStore is a Store that simulates a concurrent writer by adding
+// the given edge in both directions right before the graph tries to add it.
+type concurrentAddStore[K comparable, T any] struct {
+	Store[K, T]
+	edge Edge[K]
+}
+
+func (s *concurrentAddStore[K, T]) AddEdge(sourceHash, targetHash K, edge Edge[K]) error {
+	if sourceHash == s.edge.Source && targetHash == s.edge.Target {
+		_ = s.Store.AddEdge(s.edge.Source, s.edge.Target, s.edge)
+		_ = s.Store.AddEdge(s.edge.Target, s.edge.Source, Edge[K]{Source: s.edge.Target, Target: s.edge.Source})
+	}
+
+	return s.Store.AddEdge(sourceHash, targetHash, edge)
+}
+
 func TestUndirected_Edge(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []int
@@ -1096,6 +1280,30 @@ func TestUndirected_PredecessorMap(t *testing.T) {
 	}
 }
 
+func TestUndirected_ClonePreventCycles(t *testing.T) {
+	g := New(IntHash, PreventCycles())
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2)
+	_ = g.AddEdge(2, 3)
+
+	clone, err := g.Clone()
+	if err != nil {
+		t.Fatalf("failed to clone graph: %s", err.Error())
+	}
+
+	if !traitsAreEqual(clone.Traits(), g.Traits()) {
+		t.Errorf("expected traits %+v, got %+v", g.Traits(), clone.Traits())
+	}
+
+	if err := clone.AddEdge(3, 1); !errors.Is(err, ErrEdgeCreatesCycle) {
+		t.Errorf("expected error %v, got %v", ErrEdgeCreatesCycle, err)
+	}
+}
+
 func TestUndirected_Clone(t *testing.T) {
 	tests := map[string]struct {
 		vertices []int
@@ -1206,10 +1414,19 @@ func TestUndirected_OrderAndSize(t *testing.T) {
 			expectedOrder: 2,
 			expectedSize:  0,
 		},
+		"graph with self-loop": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			expectedOrder: 2,
+			expectedSize:  2,
+		},
 	}
 
 	for name, test := range tests {
-		graph := newUndirected(IntHash, &Traits{}, newMemoryStore[int, int]())
+		graph := newUndirected(IntHash, &Traits{AllowSelfLoops: true}, newMemoryStore[int, int]())
 
 		for _, vertex := range test.vertices {
 			_ = graph.AddVertex(vertex)
@@ -1235,6 +1452,42 @@ func TestUndirected_OrderAndSize(t *testing.T) {
 	}
 }
 
+func TestUndirected_SizeComparedToDirected(t *testing.T) {
+	edges := []Edge[int]{
+		{Source: 1, Target: 2},
+		{Source: 2, Target: 3},
+		{Source: 3, Target: 3},
+	}
+
+	directedGraph := New(IntHash, Directed(), AllowSelfLoops())
+	undirectedGraph := New(IntHash, AllowSelfLoops())
+
+	for _, g := range []Graph[int, int]{directedGraph, undirectedGraph} {
+		for _, vertex := range []int{1, 2, 3} {
+			_ = g.AddVertex(vertex)
+		}
+		for _, edge := range edges {
+			if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+				t.Fatalf("failed to add edge: %s", err.Error())
+			}
+		}
+	}
+
+	directedSize, _ := directedGraph.Size()
+	undirectedSize, _ := undirectedGraph.Size()
+
+	if directedSize != len(edges) || undirectedSize != len(edges) {
+		t.Errorf("expected size %d for both graphs, got %d (directed) and %d (undirected)", len(edges), directedSize, undirectedSize)
+	}
+
+	storedEdges, _ := undirectedGraph.(*undirected[int, int]).store.ListEdges()
+
+	// The undirected graph stores each edge twice, except for the self-loop.
+	if len(storedEdges) != 2*len(edges)-1 {
+		t.Errorf("expected %d stored edges, got %d", 2*len(edges)-1, len(storedEdges))
+	}
+}
+
 func TestUndirected_edgesAreEqual(t *testing.T) {
 	tests := map[string]struct {
 		a             Edge[int]
@@ -1414,3 +1667,43 @@ func adjacencyList[K comparable, T any](store Store[K, T], vertexHash K) []K {
 
 	return adjacencyHashes
 }
+
+func TestUndirected_AllowSelfLoops(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		expectedError error
+	}{
+		"self-loops not allowed": {
+			traits:        []func(*Traits){},
+			expectedError: ErrSelfLoop,
+		},
+		"self-loops allowed": {
+			traits:        []func(*Traits){AllowSelfLoops()},
+			expectedError: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+			_ = g.AddVertex(1)
+
+			err := g.AddEdge(1, 1)
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf("expected error %v, got %v", test.expectedError, err)
+			}
+
+			source := New(IntHash, AllowSelfLoops())
+			_ = source.AddVertex(1)
+			_ = source.AddEdge(1, 1)
+
+			target := New(IntHash, test.traits...)
+			_ = target.AddVertex(1)
+
+			err = target.AddEdgesFrom(source)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected error %v when adding edges, got %v", test.expectedError, err)
+			}
+		})
+	}
+}